@@ -2,6 +2,9 @@ package cmd
 
 import (
 	"math"
+	"strings"
+
+	"github.com/pkg/errors"
 
 	"github.com/Semior001/decompract/app/num/graph"
 	"github.com/Semior001/decompract/app/num/service"
@@ -16,6 +19,7 @@ type Server struct {
 	Port       int    `long:"service_port" env:"SERVICE_PORT" description:"http server port" default:"8080"`
 
 	WebRoot string `long:"web-root" env:"WEB_ROOT" default:"./web" description:"web root directory"`
+	Methods string `long:"methods" env:"METHODS" default:"rk4,improved-euler,euler" description:"comma-separated list of methods to run by default"`
 
 	CommonOpts
 }
@@ -35,16 +39,20 @@ func (s *Server) Execute(_ []string) error {
 	//	return 4.0/x/x - y/x - y*y, nil
 	//}
 
+	methods := solver.ParseMethods(s.Methods)
+	if _, err := solver.NewSet(methods, fxy); err != nil {
+		return errors.Wrapf(err, "failed to prepare solvers, available methods: %s",
+			strings.Join(solver.Methods(), ", "))
+	}
+
 	srv := api.Rest{
 		Version: s.Version,
 		WebRoot: s.WebRoot,
-		NumService: &service.Service{
-			Solvers: []solver.Interface{
-				&solver.RungeKutta{F: fxy},
-				&solver.ImprovedEuler{F: fxy},
-				&solver.Euler{F: fxy},
-			},
-			ExactSolver: &solver.Exact{
+		Methods: methods,
+		Plotter: graph.Plotter{},
+		Default: service.Problem{
+			F: fxy,
+			Exact: &solver.Exact{
 				// mathprofi
 				//F: func(x, c float64) (float64, error) { return c*math.Exp(-2*x) + x*x/2 - x/2 + 1/4, nil },
 				//C: func(x0, y0 float64) (float64, error) {
@@ -63,7 +71,6 @@ func (s *Server) Execute(_ []string) error {
 				//	return (2 + x0*y0) / (x0 * x0 * x0 * x0) / (2 - x0*y0), nil
 				//},
 			},
-			Plotter: graph.Plotter{},
 		},
 	}
 	srv.Run(s.Port)
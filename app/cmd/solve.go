@@ -0,0 +1,457 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	log "github.com/go-pkgz/lgr"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Semior001/decompract/app/num"
+	"github.com/Semior001/decompract/app/num/graph"
+	"github.com/Semior001/decompract/app/num/service"
+	"github.com/Semior001/decompract/app/num/solver"
+)
+
+// Solve solves the equation without running the server and writes solutions, LTE, pointwise global errors,
+// GTE, the norms of global errors and the located events as CSV and JSON files with their plots of the
+// selected format to the output directory
+type Solve struct {
+	ProblemFile string `long:"problem" short:"p" description:"YAML or JSON file with the problem, its values override the flags"`
+	Output      string `long:"output" short:"o" default:"output" description:"output directory"`
+	NoPlots     bool   `long:"no-plots" description:"don't plot graphs"`
+
+	Format     string   `long:"format" default:"png" choice:"png" choice:"svg" choice:"pdf" choice:"eps" description:"format of the plots"`
+	Width      float64  `long:"width" default:"10" description:"width of the plots in inches"`
+	Height     float64  `long:"height" description:"height of the plots in inches, equal to the width if not set"`
+	DPI        int      `long:"dpi" default:"96" description:"resolution of png plots"`
+	LogX       bool     `long:"log-x" description:"logarithmic x axis of the plots"`
+	LogY       bool     `long:"log-y" description:"logarithmic y axis of the plots"`
+	XMin       *float64 `long:"x-min" description:"lower bound of x axis of the plots, fitted to the data if not set"`
+	XMax       *float64 `long:"x-max" description:"upper bound of x axis of the plots, fitted to the data if not set"`
+	YMin       *float64 `long:"y-min" description:"lower bound of y axis of the plots, fitted to the data if not set"`
+	YMax       *float64 `long:"y-max" description:"upper bound of y axis of the plots, fitted to the data if not set"`
+	Grid       bool     `long:"grid" description:"draw grid lines in the plots"`
+	Legend     string   `long:"legend" default:"bottom-right" choice:"bottom-right" choice:"bottom-left" choice:"top-right" choice:"top-left" choice:"none" description:"placement of the legend"`
+	Styles     string   `long:"styles" description:"styles of the lines in order, separated by ';', e.g. dashed circle; dotted no-marker"`
+	SlopeField string   `long:"slope-field" default:"none" choice:"none" choice:"solutions" choice:"alone" description:"plot the slope field with or without the solutions"`
+	FamilyX0   string   `long:"family-x0" description:"initial x of the family of solutions, comma-separated values or range from:to:n"`
+	FamilyY0   string   `long:"family-y0" description:"initial y of the family of solutions, comma-separated values or range from:to:n"`
+
+	Fxy      string  `long:"fxy" description:"f(x,y) = y'"`
+	Yxc      string  `long:"yxc" description:"exact solution y(x,c), numerical reference is used if not set"`
+	C        string  `long:"c" description:"constant of the exact solution c(x0,y0)"`
+	X0       float64 `long:"x0" default:"0" description:"initial x"`
+	Y0       float64 `long:"y0" default:"1" description:"initial y"`
+	XEnd     float64 `long:"x_end" default:"1" description:"end of the interval"`
+	N        int     `long:"n" default:"10" description:"number of steps"`
+	NMin     int     `long:"nmin" default:"10" description:"minimal number of steps for GTE"`
+	NMax     int     `long:"nmax" default:"100" description:"maximal number of steps for GTE"`
+	Methods  string  `long:"methods" default:"rk4,improved-euler,euler" description:"comma-separated list of methods"`
+	Relative bool    `long:"relative" description:"calculate relative errors instead of absolute ones"`
+	Norm     string  `long:"norm" default:"max" choice:"max" choice:"l1" choice:"l2" choice:"rms" choice:"end" description:"norm of the global errors for GTE"`
+
+	Events []string `long:"event" description:"event function g(x,y), the roots of which are located on the solutions, could be repeated"`
+	Stop   bool     `long:"stop" description:"stop the integration at the first root of any event function"`
+
+	CommonOpts
+}
+
+// problem describes the initial value problem in the problem file
+type problem struct {
+	service.ProblemSpec `yaml:",inline"`
+
+	FamilyX0 service.Sweep `json:"family_x0" yaml:"family_x0"` // initial values of the family of solutions, x0 if empty
+	FamilyY0 service.Sweep `json:"family_y0" yaml:"family_y0"` // initial values of the family of solutions, y0 if empty
+}
+
+// linesFile is the content of the JSON output files
+type linesFile struct {
+	Methods []string   `json:"methods"` // method of each line, "exact" or "reference" for the exact solution
+	Lines   []num.Line `json:"lines"`
+}
+
+// Execute solves the problem and writes the results
+func (s *Solve) Execute(_ []string) error {
+	p, err := s.problem()
+	if err != nil {
+		return err
+	}
+	if err = p.validate(); err != nil {
+		return err
+	}
+
+	srv, err := s.service(p)
+	if err != nil {
+		return err
+	}
+
+	consistency, err := srv.CheckExact(p.X0, p.Y0, p.XEnd)
+	if err != nil {
+		return errors.Wrap(err, "failed to check exact solution")
+	}
+	for _, w := range consistency.Warnings() {
+		log.Printf("[WARN] %s", w)
+	}
+
+	if err = os.MkdirAll(s.Output, 0o750); err != nil {
+		return errors.Wrapf(err, "can't make output directory %s", s.Output)
+	}
+
+	// solving once, the errors, metrics and events are derived from the solutions
+	solutions, events, err := srv.SolutionsEvents(num.CalculateStepSize(p.N, p.X0, p.XEnd), p.X0, p.Y0, p.XEnd)
+	if err != nil {
+		return errors.Wrap(err, "failed to solve")
+	}
+	// the exact solution is the last line
+	exact := "exact"
+	if srv.Reference() {
+		exact = "reference"
+	}
+	methods := append(append([]string{}, p.Methods...), exact)
+	err = s.write(srv, "solutions", "X", "Y", methods, solutions, func() ([]byte, error) {
+		return srv.PlotSolutions(solutions)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = s.writeSlopeField(srv, p, solutions); err != nil {
+		return err
+	}
+	if err = s.writeFamily(srv, p); err != nil {
+		return err
+	}
+	if err = s.writeEvents(events); err != nil {
+		return err
+	}
+
+	lte, err := srv.LocalErrorsOf(p.X0, p.Y0, solutions)
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate lte")
+	}
+	err = s.write(srv, "lte", "X", "Err", p.Methods, lte, func() ([]byte, error) {
+		return srv.PlotLocalErrors(lte)
+	})
+	if err != nil {
+		return err
+	}
+
+	pointwise, err := srv.PointwiseErrorsOf(p.X0, p.Y0, solutions)
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate global errors")
+	}
+	err = s.write(srv, "pointwise", "X", "Err", p.Methods, pointwise, func() ([]byte, error) {
+		return srv.PlotPointwiseErrors(pointwise)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = s.writeMetrics(service.MetricsOf(pointwise)); err != nil {
+		return err
+	}
+
+	gte, err := srv.GlobalErrors(p.NMin, p.NMax, p.X0, p.Y0, p.XEnd)
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate gte")
+	}
+	convs, err := srv.Convergence(gte, p.X0, p.Y0, p.XEnd)
+	if err != nil {
+		return errors.Wrap(err, "failed to estimate convergence")
+	}
+	err = s.write(srv, "gte", "N", "Err", p.Methods, gte, func() ([]byte, error) { return srv.PlotConvergence(gte, convs) })
+	if err != nil {
+		return err
+	}
+	if err = s.writeConvergence(convs); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] results are written to %s", s.Output)
+	return nil
+}
+
+// validate checks, that the equation is set and the interval and the numbers of steps are valid
+func (p problem) validate() error {
+	if p.Fxy == "" {
+		return errors.New("fxy must be specified")
+	}
+	if err := service.ValidateGrid(p.X0, p.XEnd, p.N); err != nil {
+		return err
+	}
+	return service.ValidateGrids(p.X0, p.XEnd, p.NMin, p.NMax)
+}
+
+// service prepares the service, that solves the problem by its methods, with the plotter from the flags
+func (s *Solve) service(p problem) (*service.Service, error) {
+	prb, err := service.ParseProblem(p.Fxy, p.Yxc, p.C)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse functions")
+	}
+
+	plotter, err := s.plotter()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid plot options")
+	}
+
+	srv, err := service.New(prb, p.Methods, plotter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to prepare solvers, available methods: %s",
+			strings.Join(solver.Methods(), ", "))
+	}
+	if srv.Norm, err = service.ParseNorm(p.Norm); err != nil {
+		return nil, err
+	}
+	srv.Relative = p.Relative
+	if srv.Events, err = service.ParseEvents(p.Events); err != nil {
+		return nil, err
+	}
+	return srv, nil
+}
+
+// problem returns the problem from the flags, overridden by the values from the problem file
+func (s *Solve) problem() (problem, error) {
+	p := problem{ProblemSpec: service.ProblemSpec{
+		Fxy:      s.Fxy,
+		Yxc:      s.Yxc,
+		C:        s.C,
+		Methods:  solver.ParseMethods(s.Methods),
+		X0:       s.X0,
+		Y0:       s.Y0,
+		XEnd:     s.XEnd,
+		N:        s.N,
+		NMin:     s.NMin,
+		NMax:     s.NMax,
+		Relative: s.Relative,
+		Norm:     s.Norm,
+	}}
+	for _, g := range s.Events {
+		p.Events = append(p.Events, service.EventSpec{G: g, Terminal: s.Stop})
+	}
+	familyX0, err := service.ParseSweep(s.FamilyX0)
+	if err != nil {
+		return problem{}, errors.Wrap(err, "can't read initial x of family")
+	}
+	familyY0, err := service.ParseSweep(s.FamilyY0)
+	if err != nil {
+		return problem{}, errors.Wrap(err, "can't read initial y of family")
+	}
+	if s.ProblemFile == "" {
+		p.FamilyX0, p.FamilyY0 = familyX0, familyY0
+		return p, nil
+	}
+
+	b, err := ioutil.ReadFile(s.ProblemFile)
+	if err != nil {
+		return problem{}, errors.Wrapf(err, "can't read problem file %s", s.ProblemFile)
+	}
+
+	if strings.EqualFold(filepath.Ext(s.ProblemFile), ".json") {
+		err = json.Unmarshal(b, &p)
+	} else {
+		err = yaml.Unmarshal(b, &p)
+	}
+	if err != nil {
+		return problem{}, errors.Wrapf(err, "can't decode problem file %s", s.ProblemFile)
+	}
+
+	// sweeps are overridden as a whole, as the values and the range of the sweep are exclusive
+	if p.FamilyX0.Empty() {
+		p.FamilyX0 = familyX0
+	}
+	if p.FamilyY0.Empty() {
+		p.FamilyY0 = familyY0
+	}
+	return p, nil
+}
+
+// plotter returns the plotter with the format, the size and the axes from the flags
+func (s *Solve) plotter() (graph.Plotter, error) {
+	pl, err := graph.NewPlotter(s.Format, s.Width, s.Height, s.DPI)
+	if err != nil {
+		return graph.Plotter{}, err
+	}
+	pl.Options = graph.Options{LogX: s.LogX, LogY: s.LogY, XMin: s.XMin, XMax: s.XMax, YMin: s.YMin, YMax: s.YMax,
+		Grid: s.Grid, Legend: graph.Legend(s.Legend)}
+	if pl.Options.Styles, err = graph.ParseStyles(s.Styles); err != nil {
+		return graph.Plotter{}, err
+	}
+	return pl, pl.Options.Validate()
+}
+
+// write writes the lines to the CSV and JSON files with the given name and saves their plot,
+// encoded by the plotter of the service, xTitle and yTitle are used as the names of the columns in CSV,
+// methods are the names of the methods of the lines, index for index
+func (s *Solve) write(srv *service.Service, name, xTitle, yTitle string, methods []string, lines []num.Line,
+	plot func() ([]byte, error)) error {
+	if err := s.writeCSV(name+".csv", []string{"method", strings.ToLower(xTitle), strings.ToLower(yTitle)},
+		linesRows(lines)); err != nil {
+		return err
+	}
+
+	if err := s.writeJSON(name+".json", linesFile{Methods: methods, Lines: lines}); err != nil {
+		return err
+	}
+
+	if s.NoPlots {
+		return nil
+	}
+
+	img, err := plot()
+	if err != nil {
+		return errors.Wrapf(err, "can't plot %s", name)
+	}
+	file := name + "." + srv.Plotter.Ext()
+	if err = ioutil.WriteFile(filepath.Join(s.Output, file), img, 0o640); err != nil {
+		return errors.Wrapf(err, "can't write %s", file)
+	}
+	return nil
+}
+
+// writeSlopeField saves the plot of the slope field of the equation, overlaid by the solutions, if it is set by the flags
+func (s *Solve) writeSlopeField(srv *service.Service, p problem, solutions []num.Line) error {
+	if s.SlopeField == "" || s.SlopeField == "none" || s.NoPlots {
+		return nil
+	}
+	img, err := srv.PlotSlopeField(p.X0, p.Y0, p.XEnd, solutions, s.SlopeField == "solutions")
+	if err != nil {
+		return errors.Wrap(err, "can't plot slope field")
+	}
+	file := "slope_field." + srv.Plotter.Ext()
+	if err = ioutil.WriteFile(filepath.Join(s.Output, file), img, 0o640); err != nil {
+		return errors.Wrapf(err, "can't write %s", file)
+	}
+	return nil
+}
+
+// writeFamily writes the family of solutions from the swept initial values to the CSV and JSON files and saves its plot,
+// if any of the initial values is swept
+func (s *Solve) writeFamily(srv *service.Service, p problem) error {
+	if p.FamilyX0.Empty() && p.FamilyY0.Empty() {
+		return nil
+	}
+	x0s, err := p.FamilyX0.Points(p.X0)
+	if err != nil {
+		return errors.Wrap(err, "invalid initial x of family")
+	}
+	y0s, err := p.FamilyY0.Points(p.Y0)
+	if err != nil {
+		return errors.Wrap(err, "invalid initial y of family")
+	}
+
+	fam, err := srv.SolutionFamily(p.N, x0s, y0s, p.XEnd)
+	if err != nil {
+		return errors.Wrap(err, "failed to solve family")
+	}
+	// the family is solved by the first method only
+	methods := make([]string, len(fam.Lines))
+	for i := range methods {
+		methods[i] = p.Methods[0]
+	}
+	return s.write(srv, "family", "X", "Y", methods, fam.Lines, func() ([]byte, error) {
+		return srv.Plotter.PlotFamily("Family of solutions, "+fam.Method, "X", "Y", fam.Lines)
+	})
+}
+
+// writeConvergence writes the estimated orders of convergence to the CSV and JSON files
+func (s *Solve) writeConvergence(convs []service.Convergence) error {
+	var rows [][]string
+	for _, c := range convs {
+		rows = append(rows, []string{c.Name, formatFloat(c.Order), formatFloat(c.StdErr), formatFloat(c.R2),
+			formatFloat(c.CoarseOrder), formatFloat(c.FineOrder), c.Note()})
+	}
+	header := []string{"method", "order", "std_err", "r2", "coarse_order", "fine_order", "note"}
+	if err := s.writeCSV("convergence.csv", header, rows); err != nil {
+		return err
+	}
+	return s.writeJSON("convergence.json", convs)
+}
+
+// writeMetrics writes the norms of the global errors to the CSV and JSON files
+func (s *Solve) writeMetrics(metrics []service.Metrics) error {
+	var rows [][]string
+	for _, m := range metrics {
+		rows = append(rows, []string{m.Name, formatFloat(m.Max), formatFloat(m.L1), formatFloat(m.L2),
+			formatFloat(m.RMS), formatFloat(m.End)})
+	}
+	header := []string{"method", "max", "l1", "l2", "rms", "end"}
+	if err := s.writeCSV("metrics.csv", header, rows); err != nil {
+		return err
+	}
+	return s.writeJSON("metrics.json", metrics)
+}
+
+// writeEvents writes the events, located on the solutions, to the CSV and JSON files, if there are any
+func (s *Solve) writeEvents(events []service.MethodEvents) error {
+	if events == nil {
+		return nil
+	}
+	var rows [][]string
+	for _, m := range events {
+		for _, e := range m.Events {
+			rows = append(rows, []string{m.Name, e.Event, formatFloat(e.X), formatFloat(e.Y),
+				strconv.FormatBool(e.Terminal)})
+		}
+	}
+	header := []string{"method", "event", "x", "y", "terminal"}
+	if err := s.writeCSV("events.csv", header, rows); err != nil {
+		return err
+	}
+	return s.writeJSON("events.json", events)
+}
+
+// writeJSON writes the indented JSON representation of v to the file
+func (s *Solve) writeJSON(file string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "can't marshal %s", file)
+	}
+	if err = ioutil.WriteFile(filepath.Join(s.Output, file), b, 0o640); err != nil {
+		return errors.Wrapf(err, "can't write %s", file)
+	}
+	return nil
+}
+
+// linesRows makes the CSV rows of the points of the lines in the form "method,x,y", a row per point
+func linesRows(lines []num.Line) [][]string {
+	var rows [][]string
+	for _, line := range lines {
+		for _, pt := range line.Points {
+			rows = append(rows, []string{line.Name, formatFloat(pt.X), formatFloat(pt.Y)})
+		}
+	}
+	return rows
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// writeCSV writes the header and the rows to the CSV file
+func (s *Solve) writeCSV(file string, header []string, rows [][]string) (err error) {
+	f, err := os.Create(filepath.Join(s.Output, file))
+	if err != nil {
+		return errors.Wrapf(err, "can't create %s", file)
+	}
+	defer func() {
+		if cerr := f.Close(); cerr != nil && err == nil {
+			err = errors.Wrapf(cerr, "can't close %s", file)
+		}
+	}()
+
+	w := csv.NewWriter(f)
+	if err = w.Write(header); err != nil {
+		return errors.Wrapf(err, "can't write %s", file)
+	}
+	if err = w.WriteAll(rows); err != nil {
+		return errors.Wrapf(err, "can't write %s", file)
+	}
+	return nil
+}
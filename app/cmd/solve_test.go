@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolve_Execute(t *testing.T) {
+	t.Run("problem file overrides the flags", func(t *testing.T) {
+		dir := tempDir(t)
+		problemFile := filepath.Join(dir, "problem.yaml")
+		require.NoError(t, ioutil.WriteFile(problemFile, []byte(`
+fxy: x
+yxc: x*x/2 + c
+c: y0 - x0*x0/2
+x_end: 2
+nmin: 5
+nmax: 7
+methods: [heun, euler]
+`), 0o600))
+
+		out := filepath.Join(dir, "out")
+		s := Solve{ProblemFile: problemFile, Output: out, NoPlots: true, X0: 0, Y0: 1, XEnd: 1, N: 10,
+			NMin: 10, NMax: 100, Methods: "rk4"}
+		require.NoError(t, s.Execute(nil))
+
+		for _, name := range []string{"solutions", "lte", "pointwise", "gte", "convergence", "metrics"} {
+			assert.FileExists(t, filepath.Join(out, name+".csv"))
+			assert.FileExists(t, filepath.Join(out, name+".json"))
+			if name == "convergence" || name == "metrics" {
+				continue
+			}
+			_, err := os.Stat(filepath.Join(out, name+".png"))
+			assert.True(t, os.IsNotExist(err), name)
+		}
+		_, err := os.Stat(filepath.Join(out, "events.csv"))
+		assert.True(t, os.IsNotExist(err), "events are written only if they are specified")
+
+		rows := readCSV(t, filepath.Join(out, "solutions.csv"))
+		// header and 11 points for each of two methods and the exact solution
+		require.Equal(t, 1+3*11, len(rows))
+		assert.Equal(t, []string{"method", "x", "y"}, rows[0])
+		assert.Equal(t, []string{"Heun's method", "0", "1"}, rows[1])
+		assert.Equal(t, "Exact solution", rows[len(rows)-1][0])
+
+		// methods match the lines index for index
+		solutions := readLines(t, filepath.Join(out, "solutions.json"))
+		assert.Equal(t, []string{"heun", "euler", "exact"}, solutions.Methods)
+		require.Equal(t, 3, len(solutions.Lines))
+		assert.Equal(t, "Exact solution", solutions.Lines[2].Name)
+
+		gte := readLines(t, filepath.Join(out, "gte.json"))
+		assert.Equal(t, []string{"heun", "euler"}, gte.Methods)
+		require.Equal(t, 2, len(gte.Lines))
+		assert.Equal(t, 3, len(gte.Lines[1].Points))
+		assert.Equal(t, 5.0, gte.Lines[1].Points[0].X)
+	})
+
+	t.Run("events stop the solutions", func(t *testing.T) {
+		out := tempDir(t)
+		s := Solve{Output: out, NoPlots: true, Fxy: "x", Yxc: "x*x/2 + c", C: "y0 - x0*x0/2", X0: 0, Y0: 1, XEnd: 1,
+			N: 10, NMin: 5, NMax: 6, Methods: "rk4", Events: []string{"y - 1.125", "x - 0.9"}, Stop: true}
+		require.NoError(t, s.Execute(nil))
+
+		rows := readCSV(t, filepath.Join(out, "events.csv"))
+		require.Equal(t, 2, len(rows))
+		assert.Equal(t, []string{"method", "event", "x", "y", "terminal"}, rows[0])
+		assert.Equal(t, []string{"Runge-Kutta's method", "y - 1.125"}, rows[1][:2])
+		assert.Equal(t, "true", rows[1][4])
+		x, err := strconv.ParseFloat(rows[1][2], 64)
+		require.NoError(t, err)
+		assert.InDelta(t, 0.5, x, 1e-9)
+	})
+
+	t.Run("plots", func(t *testing.T) {
+		out := tempDir(t)
+		s := Solve{Output: out, Fxy: "x", Yxc: "x*x/2 + c", C: "y0 - x0*x0/2", X0: 0, Y0: 1, XEnd: 1, N: 10,
+			NMin: 5, NMax: 6, Methods: "euler"}
+		require.NoError(t, s.Execute(nil))
+		assert.FileExists(t, filepath.Join(out, "solutions.png"))
+		assert.FileExists(t, filepath.Join(out, "gte.png"))
+	})
+
+	t.Run("vector plots", func(t *testing.T) {
+		out := tempDir(t)
+		s := Solve{Output: out, Fxy: "x", Yxc: "x*x/2 + c", C: "y0 - x0*x0/2", X0: 0, Y0: 1, XEnd: 1, N: 10,
+			NMin: 5, NMax: 6, Methods: "euler", Format: "svg", Width: 4, Height: 3, LogY: true, Grid: true,
+			Legend: "top-left", Styles: "dashed circle; no-line", SlopeField: "alone"}
+		require.NoError(t, s.Execute(nil))
+		assert.FileExists(t, filepath.Join(out, "slope_field.svg"))
+		b, err := ioutil.ReadFile(filepath.Join(out, "lte.svg"))
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `<svg width="288pt" height="216pt"`)
+	})
+
+	t.Run("family of solutions", func(t *testing.T) {
+		dir := tempDir(t)
+		// the flags are overridden by the problem file
+		familyFile := filepath.Join(dir, "family.json")
+		require.NoError(t, ioutil.WriteFile(familyFile, []byte(`{"fxy": "-y", "x0": 0, "y0": 1, "x_end": 1, "n": 10,
+		"nmin": 5, "nmax": 6, "methods": ["rk4", "euler"], "family_y0": {"from": -1, "to": 1, "n": 5}}`), 0o600))
+		out := filepath.Join(dir, "out")
+		s := Solve{ProblemFile: familyFile, Output: out, FamilyY0: "1, 2"}
+		require.NoError(t, s.Execute(nil))
+		assert.FileExists(t, filepath.Join(out, "family.png"))
+
+		family := readLines(t, filepath.Join(out, "family.json"))
+		require.Equal(t, 5, len(family.Lines))
+		assert.Equal(t, []string{"rk4", "rk4", "rk4", "rk4", "rk4"}, family.Methods)
+		assert.Equal(t, "y0 = -0.5", family.Lines[1].Name)
+
+		solutions := readLines(t, filepath.Join(out, "solutions.json"))
+		assert.Equal(t, []string{"rk4", "euler", "reference"}, solutions.Methods)
+	})
+
+	t.Run("backwards", func(t *testing.T) {
+		// the errors of the wrong exact solution are the same for all N
+		s := Solve{Output: tempDir(t), Fxy: "x", Yxc: "x", C: "1", X0: 1, Y0: 0, XEnd: 0, N: 10, NMin: 5, NMax: 6,
+			Methods: "euler"}
+		require.NoError(t, s.Execute(nil))
+	})
+
+	t.Run("invalid problems", func(t *testing.T) {
+		dir := tempDir(t)
+		tbl := []Solve{
+			{Fxy: "x", Yxc: "x", X0: 0, XEnd: 1, N: 10, NMin: 5, NMax: 6, Methods: "euler"},
+			{Fxy: "x", Yxc: "x", C: "1", X0: 1, XEnd: 1, N: 10, NMin: 5, NMax: 6, Methods: "euler"},
+			{Fxy: "x", Yxc: "x", C: "1", X0: 0, XEnd: 1, N: 10, NMin: 7, NMax: 6, Methods: "euler"},
+			{Fxy: "x", Yxc: "x", C: "1", X0: 0, XEnd: 1, N: 10, NMin: 5, NMax: 6, Methods: "unknown"},
+			{ProblemFile: filepath.Join(dir, "nonexistent.json"), Methods: "euler"},
+			{Fxy: "x", Yxc: "x", C: "1", X0: 0, XEnd: 1, N: 10, NMin: 5, NMax: 6, Methods: "euler", Width: 20, DPI: 600},
+			{Fxy: "x", Yxc: "x", C: "1", X0: 0, XEnd: 1, N: 10, NMin: 5, NMax: 6, Methods: "euler", Styles: "wavy"},
+			{Fxy: "x", Yxc: "x", C: "1", X0: 0, XEnd: 1, N: 10, NMin: 5, NMax: 6, Methods: "euler", FamilyY0: "1:2"},
+			{Fxy: "x", Yxc: "x", C: "1", X0: 0, XEnd: 1, N: 10, NMin: 5, NMax: 6, Methods: "euler", FamilyX0: "0, 1"},
+		}
+		for i, entry := range tbl {
+			entry.Output = filepath.Join(dir, strconv.Itoa(i))
+			assert.Error(t, entry.Execute(nil), "case %d", i)
+		}
+	})
+}
+
+// tempDir makes the temporary directory, removed after the test
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "decompract-solve")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func readCSV(t *testing.T, file string) [][]string {
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+	rows, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+	return rows
+}
+
+func readLines(t *testing.T, file string) linesFile {
+	b, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	var res linesFile
+	require.NoError(t, json.Unmarshal(b, &res))
+	return res
+}
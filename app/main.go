@@ -13,7 +13,9 @@ import (
 
 // Opts describes cli arguments and flags to execute a command
 type Opts struct {
-	cmd.Server
+	ServerCmd cmd.Server `command:"server" description:"run the web server"`
+	SolveCmd  cmd.Solve  `command:"solve" description:"solve the equation and write the results to files"`
+
 	Dbg bool `long:"dbg" env:"DEBUG" description:"turn on debug mode"`
 }
 
@@ -23,26 +25,41 @@ func main() {
 	fmt.Printf("decompract version: %s\n", version)
 	var opts Opts
 	p := flags.NewParser(&opts, flags.Default)
+	p.CommandHandler = func(command flags.Commander, args []string) error {
+		setupLog(opts.Dbg)
+
+		// commands implements CommonOptionsCommander to allow passing set of extra options defined for all commands
+		c := command.(cmd.CommonOptionsCommander)
+		c.SetCommon(cmd.CommonOpts{
+			Version: version,
+		})
+
+		err := c.Execute(args)
+		if err != nil {
+			log.Printf("[ERROR] failed to execute command %+v", err)
+		}
+		return err
+	}
 
-	// after failure command does not return non-zero code
-	if _, err := p.Parse(); err != nil {
+	// exit with non-zero code on failure of the command
+	if _, err := p.ParseArgs(defaultCommand(p, os.Args[1:], "server")); err != nil {
 		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
 			os.Exit(0)
 		} else {
 			os.Exit(1)
 		}
 	}
+}
 
-	setupLog(opts.Dbg)
-
-	// commands implements CommonOptionsCommander to allow passing set of extra options defined for all commands
-	opts.SetCommon(cmd.CommonOpts{
-		Version: version,
-	})
-
-	if err := opts.Execute(os.Args[1:]); err != nil {
-		log.Printf("[ERROR] failed to execute command %+v", err)
+// defaultCommand prepends the name of the default command to the arguments, if none of the commands
+// is given, so the server is started without the command, as before the commands were added
+func defaultCommand(p *flags.Parser, args []string, name string) []string {
+	for _, arg := range args {
+		if p.Find(arg) != nil || arg == "-h" || arg == "--help" {
+			return args
+		}
 	}
+	return append([]string{name}, args...)
 }
 
 func setupLog(dbg bool) {
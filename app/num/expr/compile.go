@@ -0,0 +1,226 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// constants are the named constants, available in the expressions,
+// they could be also called as functions without arguments, e.g. pi()
+var constants = map[string]float64{
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+// unaryFuncs are the functions of one argument, available in the expressions
+var unaryFuncs = map[string]func(float64) float64{
+	"exp":   math.Exp,
+	"ln":    math.Log,
+	"log":   math.Log,
+	"log10": math.Log10,
+	"sqrt":  math.Sqrt,
+	"abs":   math.Abs,
+	"sign":  sign,
+	"sin":   math.Sin,
+	"cos":   math.Cos,
+	"tan":   math.Tan,
+	"asin":  math.Asin,
+	"acos":  math.Acos,
+	"atan":  math.Atan,
+	"sinh":  math.Sinh,
+	"cosh":  math.Cosh,
+	"tanh":  math.Tanh,
+}
+
+// binaryFuncs are the functions of two arguments, available in the expressions
+var binaryFuncs = map[string]func(a, b float64) float64{
+	"atan2": math.Atan2,
+	"pow":   math.Pow,
+	"min":   math.Min,
+	"max":   math.Max,
+}
+
+func sign(v float64) float64 {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return v // keeps zero and NaN
+}
+
+// evalFn evaluates the compiled expression, the variables are bound either
+// to the scalar arguments a and b or to the elements of v, so the evaluation
+// doesn't allocate
+type evalFn func(a, b float64, v []float64) float64
+
+// slots of the variables, the slot i >= slotV is bound to v[i-slotV]
+const (
+	slotA = 0
+	slotB = 1
+	slotV = 2
+)
+
+// compiled is the compiled node, constant subtrees are folded into values
+type compiled struct {
+	fn    evalFn
+	konst bool
+	val   float64
+}
+
+func constant(v float64) compiled {
+	return compiled{fn: func(_, _ float64, _ []float64) float64 { return v }, konst: true, val: v}
+}
+
+// compile compiles the syntax tree into the closure, vars maps the names of the variables to their slots
+func compile(n Node, vars map[string]int) (evalFn, error) {
+	c, err := compileNode(n, vars)
+	if err != nil {
+		return nil, err
+	}
+	return c.fn, nil
+}
+
+func compileNode(n Node, vars map[string]int) (compiled, error) {
+	switch n := n.(type) {
+	case *Num:
+		return constant(n.Val), nil
+	case *Var:
+		return compileVar(n, vars)
+	case *Unary:
+		x, err := compileNode(n.X, vars)
+		if err != nil {
+			return compiled{}, err
+		}
+		if x.konst {
+			return constant(-x.val), nil
+		}
+		xf := x.fn
+		return compiled{fn: func(a, b float64, v []float64) float64 { return -xf(a, b, v) }}, nil
+	case *Binary:
+		return compileBinary(n, vars)
+	case *Call:
+		return compileCall(n, vars)
+	}
+	return compiled{}, errors.Errorf("unknown node %T", n)
+}
+
+func compileVar(n *Var, vars map[string]int) (compiled, error) {
+	slot, ok := vars[n.Name]
+	if !ok {
+		if v, ok := constants[n.Name]; ok {
+			return constant(v), nil
+		}
+		return compiled{}, &Error{Col: n.Col, Msg: fmt.Sprintf("unknown variable %s", n.Name)}
+	}
+
+	switch slot {
+	case slotA:
+		return compiled{fn: func(a, _ float64, _ []float64) float64 { return a }}, nil
+	case slotB:
+		return compiled{fn: func(_, b float64, _ []float64) float64 { return b }}, nil
+	}
+	i := slot - slotV
+	return compiled{fn: func(_, _ float64, v []float64) float64 { return v[i] }}, nil
+}
+
+func compileBinary(n *Binary, vars map[string]int) (compiled, error) {
+	l, err := compileNode(n.L, vars)
+	if err != nil {
+		return compiled{}, err
+	}
+	r, err := compileNode(n.R, vars)
+	if err != nil {
+		return compiled{}, err
+	}
+
+	op := binaryOp(n.Op)
+	if l.konst && r.konst {
+		return constant(op(l.val, r.val)), nil
+	}
+
+	lf, rf := l.fn, r.fn
+	switch n.Op {
+	case '+':
+		return compiled{fn: func(a, b float64, v []float64) float64 { return lf(a, b, v) + rf(a, b, v) }}, nil
+	case '-':
+		return compiled{fn: func(a, b float64, v []float64) float64 { return lf(a, b, v) - rf(a, b, v) }}, nil
+	case '*':
+		return compiled{fn: func(a, b float64, v []float64) float64 { return lf(a, b, v) * rf(a, b, v) }}, nil
+	case '/':
+		return compiled{fn: func(a, b float64, v []float64) float64 { return lf(a, b, v) / rf(a, b, v) }}, nil
+	}
+
+	// squares are the most common powers in the equations
+	if r.konst && r.val == 2 {
+		return compiled{fn: func(a, b float64, v []float64) float64 {
+			x := lf(a, b, v)
+			return x * x
+		}}, nil
+	}
+	return compiled{fn: func(a, b float64, v []float64) float64 { return math.Pow(lf(a, b, v), rf(a, b, v)) }}, nil
+}
+
+// binaryOp returns the function of the binary operator
+func binaryOp(op byte) func(l, r float64) float64 {
+	switch op {
+	case '+':
+		return func(l, r float64) float64 { return l + r }
+	case '-':
+		return func(l, r float64) float64 { return l - r }
+	case '*':
+		return func(l, r float64) float64 { return l * r }
+	case '/':
+		return func(l, r float64) float64 { return l / r }
+	}
+	return math.Pow
+}
+
+func compileCall(n *Call, vars map[string]int) (compiled, error) {
+	args := make([]compiled, len(n.Args))
+	for i, arg := range n.Args {
+		c, err := compileNode(arg, vars)
+		if err != nil {
+			return compiled{}, err
+		}
+		args[i] = c
+	}
+
+	arityErr := func(arity int) error {
+		return &Error{Col: n.Col, Msg: fmt.Sprintf("function %s takes %d argument(s), got %d", n.Func, arity, len(args))}
+	}
+
+	if v, ok := constants[n.Func]; ok {
+		if len(args) != 0 {
+			return compiled{}, arityErr(0)
+		}
+		return constant(v), nil
+	}
+
+	if f, ok := unaryFuncs[n.Func]; ok {
+		if len(args) != 1 {
+			return compiled{}, arityErr(1)
+		}
+		if args[0].konst {
+			return constant(f(args[0].val)), nil
+		}
+		xf := args[0].fn
+		return compiled{fn: func(a, b float64, v []float64) float64 { return f(xf(a, b, v)) }}, nil
+	}
+
+	if f, ok := binaryFuncs[n.Func]; ok {
+		if len(args) != 2 {
+			return compiled{}, arityErr(2)
+		}
+		if args[0].konst && args[1].konst {
+			return constant(f(args[0].val, args[1].val)), nil
+		}
+		xf, yf := args[0].fn, args[1].fn
+		return compiled{fn: func(a, b float64, v []float64) float64 { return f(xf(a, b, v), yf(a, b, v)) }}, nil
+	}
+
+	return compiled{}, &Error{Col: n.Col, Msg: fmt.Sprintf("unknown function %s", n.Func)}
+}
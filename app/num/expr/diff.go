@@ -0,0 +1,252 @@
+package expr
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// Diff returns the derivative of the syntax tree by the variable v, all other
+// variables are considered independent of v, the result is simplified, so
+// the constant subtrees are folded and the trivial operations are dropped
+func Diff(n Node, v string) (Node, error) {
+	switch n := n.(type) {
+	case *Num:
+		return zero(), nil
+	case *Var:
+		if n.Name == v {
+			return &Num{Val: 1}, nil
+		}
+		return zero(), nil
+	case *Unary:
+		dx, err := Diff(n.X, v)
+		if err != nil {
+			return nil, err
+		}
+		return neg(dx), nil
+	case *Binary:
+		return diffBinary(n, v)
+	case *Call:
+		return diffCall(n, v)
+	}
+	return nil, errors.Errorf("unknown node %T", n)
+}
+
+func diffBinary(n *Binary, v string) (Node, error) {
+	u, w := n.L, n.R
+	du, err := Diff(u, v)
+	if err != nil {
+		return nil, err
+	}
+	dw, err := Diff(w, v)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.Op {
+	case '+':
+		return add(du, dw), nil
+	case '-':
+		return sub(du, dw), nil
+	case '*':
+		return add(mul(du, w), mul(u, dw)), nil
+	case '/':
+		return div(sub(mul(du, w), mul(u, dw)), pow(w, &Num{Val: 2})), nil
+	}
+	return diffPow(u, w, du, dw), nil
+}
+
+// diffPow returns the derivative of u^w, the exponent is usually constant
+func diffPow(u, w, du, dw Node) Node {
+	if isNum(dw, 0) {
+		return mul(mul(w, pow(u, sub(w, &Num{Val: 1}))), du)
+	}
+	// (u^w)' = u^w * (w' ln(u) + w u'/u)
+	return mul(pow(u, w), add(mul(dw, call("ln", u)), div(mul(w, du), u)))
+}
+
+func diffCall(n *Call, v string) (Node, error) {
+	if _, ok := constants[n.Func]; ok && len(n.Args) == 0 {
+		return zero(), nil
+	}
+
+	ds := make([]Node, len(n.Args))
+	for i, arg := range n.Args {
+		d, err := Diff(arg, v)
+		if err != nil {
+			return nil, err
+		}
+		ds[i] = d
+	}
+
+	if len(n.Args) == 2 {
+		u, w, du, dw := n.Args[0], n.Args[1], ds[0], ds[1]
+		switch n.Func {
+		case "pow":
+			return diffPow(u, w, du, dw), nil
+		case "atan2":
+			// atan2(u, w)' = (w u' - u w') / (u^2 + w^2)
+			return div(sub(mul(w, du), mul(u, dw)), add(pow(u, &Num{Val: 2}), pow(w, &Num{Val: 2}))), nil
+		case "min", "max":
+			// min(u, w) = (u + w)/2 - |u - w|/2, max(u, w) = (u + w)/2 + |u - w|/2
+			half := div(add(du, dw), &Num{Val: 2})
+			jump := div(mul(call("sign", sub(u, w)), sub(du, dw)), &Num{Val: 2})
+			if n.Func == "min" {
+				return sub(half, jump), nil
+			}
+			return add(half, jump), nil
+		}
+	}
+
+	if len(n.Args) != 1 {
+		return nil, &Error{Col: n.Col, Msg: "can't differentiate function " + n.Func}
+	}
+
+	u, du := n.Args[0], ds[0]
+	var d Node // derivative of the function by its argument
+	switch n.Func {
+	case "exp":
+		d = call("exp", u)
+	case "ln", "log":
+		d = div(&Num{Val: 1}, u)
+	case "log10":
+		d = div(&Num{Val: 1}, mul(u, &Num{Val: math.Ln10}))
+	case "sqrt":
+		d = div(&Num{Val: 1}, mul(&Num{Val: 2}, call("sqrt", u)))
+	case "abs":
+		d = call("sign", u)
+	case "sign":
+		d = zero()
+	case "sin":
+		d = call("cos", u)
+	case "cos":
+		d = neg(call("sin", u))
+	case "tan":
+		d = div(&Num{Val: 1}, pow(call("cos", u), &Num{Val: 2}))
+	case "asin":
+		d = div(&Num{Val: 1}, call("sqrt", sub(&Num{Val: 1}, pow(u, &Num{Val: 2}))))
+	case "acos":
+		d = neg(div(&Num{Val: 1}, call("sqrt", sub(&Num{Val: 1}, pow(u, &Num{Val: 2})))))
+	case "atan":
+		d = div(&Num{Val: 1}, add(&Num{Val: 1}, pow(u, &Num{Val: 2})))
+	case "sinh":
+		d = call("cosh", u)
+	case "cosh":
+		d = call("sinh", u)
+	case "tanh":
+		d = div(&Num{Val: 1}, pow(call("cosh", u), &Num{Val: 2}))
+	default:
+		return nil, &Error{Col: n.Col, Msg: "can't differentiate function " + n.Func}
+	}
+	return mul(d, du), nil
+}
+
+func zero() Node { return &Num{Val: 0} }
+
+func isNum(n Node, v float64) bool {
+	num, ok := n.(*Num)
+	return ok && num.Val == v
+}
+
+func call(f string, args ...Node) Node {
+	c := &Call{Func: f, Args: args}
+	if len(args) == 1 {
+		if x, ok := args[0].(*Num); ok {
+			if fn, ok := unaryFuncs[f]; ok {
+				return &Num{Val: fn(x.Val)}
+			}
+		}
+	}
+	return c
+}
+
+func neg(x Node) Node {
+	switch x := x.(type) {
+	case *Num:
+		return &Num{Val: -x.Val}
+	case *Unary:
+		return x.X
+	}
+	return &Unary{X: x}
+}
+
+// fold returns the folded binary operation, if both operands are numbers
+func fold(op byte, l, r Node) (Node, bool) {
+	ln, lok := l.(*Num)
+	rn, rok := r.(*Num)
+	if !lok || !rok {
+		return nil, false
+	}
+	return &Num{Val: binaryOp(op)(ln.Val, rn.Val)}, true
+}
+
+func add(l, r Node) Node {
+	if n, ok := fold('+', l, r); ok {
+		return n
+	}
+	switch {
+	case isNum(l, 0):
+		return r
+	case isNum(r, 0):
+		return l
+	}
+	return &Binary{Op: '+', L: l, R: r}
+}
+
+func sub(l, r Node) Node {
+	if n, ok := fold('-', l, r); ok {
+		return n
+	}
+	switch {
+	case isNum(r, 0):
+		return l
+	case isNum(l, 0):
+		return neg(r)
+	}
+	return &Binary{Op: '-', L: l, R: r}
+}
+
+func mul(l, r Node) Node {
+	if n, ok := fold('*', l, r); ok {
+		return n
+	}
+	switch {
+	case isNum(l, 0) || isNum(r, 0):
+		return zero()
+	case isNum(l, 1):
+		return r
+	case isNum(r, 1):
+		return l
+	case isNum(l, -1):
+		return neg(r)
+	case isNum(r, -1):
+		return neg(l)
+	}
+	return &Binary{Op: '*', L: l, R: r}
+}
+
+func div(l, r Node) Node {
+	if n, ok := fold('/', l, r); ok {
+		return n
+	}
+	switch {
+	case isNum(l, 0):
+		return zero()
+	case isNum(r, 1):
+		return l
+	}
+	return &Binary{Op: '/', L: l, R: r}
+}
+
+func pow(l, r Node) Node {
+	if n, ok := fold('^', l, r); ok {
+		return n
+	}
+	switch {
+	case isNum(r, 0):
+		return &Num{Val: 1}
+	case isNum(r, 1):
+		return l
+	}
+	return &Binary{Op: '^', L: l, R: r}
+}
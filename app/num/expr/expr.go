@@ -0,0 +1,238 @@
+// Package expr parses the math expressions, typed by users, into the functions,
+// that could be evaluated by the solvers.
+package expr
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Semior001/decompract/app/num"
+)
+
+// Func2 parses the expression of two variables with the given names,
+// e.g. Func2("x*x - 2*y", "x", "y") for f(x,y) = x^2 - 2y
+func Func2(src, a, b string) (func(a, b float64) (float64, error), error) {
+	n, err := Parse(src)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't parse %s", src)
+	}
+	fn, err := func2(n, a, b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't compile %s", src)
+	}
+	return fn, nil
+}
+
+// Partial parses the expression of two variables a and b and returns its
+// partial derivative by the variable v, e.g. Partial("x*y^2", "x", "y", "y") for 2xy
+func Partial(src, a, b, v string) (func(a, b float64) (float64, error), error) {
+	n, err := Parse(src)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't parse %s", src)
+	}
+	d, err := Diff(n, v)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't differentiate %s by %s", src, v)
+	}
+	fn, err := func2(d, a, b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't compile the derivative of %s by %s", src, v)
+	}
+	return fn, nil
+}
+
+// TotalDerivatives parses f(x,y) of the equation y' = f(x,y) and returns n total
+// derivatives of y of the orders 2, 3, ..., n+1 along the solution as the functions
+// of x and y, each of them is derived from the previous one g as Dg = ∂g/∂x + ∂g/∂y * f
+func TotalDerivatives(src, x, y string, n int) ([]func(x, y float64) (float64, error), error) {
+	f, err := Parse(src)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't parse %s", src)
+	}
+
+	res := make([]func(x, y float64) (float64, error), 0, n)
+	g := f
+	for i := 0; i < n; i++ {
+		gx, err := Diff(g, x)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't differentiate %s by %s", src, x)
+		}
+		gy, err := Diff(g, y)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't differentiate %s by %s", src, y)
+		}
+		g = add(gx, mul(gy, f))
+
+		fn, err := func2(g, x, y)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't compile the derivative of order %d", i+2)
+		}
+		res = append(res, fn)
+	}
+	return res, nil
+}
+
+// func2 compiles the syntax tree of the expression of two variables
+func func2(n Node, a, b string) (func(a, b float64) (float64, error), error) {
+	fn, err := compile(n, map[string]int{a: slotA, b: slotB})
+	if err != nil {
+		return nil, err
+	}
+	return func(va, vb float64) (float64, error) { return fn(va, vb, nil), nil }, nil
+}
+
+// Split splits the list of expressions, separated by ';'
+func Split(s string) []string {
+	var res []string
+	for _, e := range strings.Split(s, ";") {
+		if e = strings.TrimSpace(e); e != "" {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+// System parses the right-hand sides of the system of equations, each
+// expression may refer to x and to the components of the state as y1, y2, ..., yn,
+// y is an alias for y1
+func System(exprs []string) (func(x float64, y num.Vector) (num.Vector, error), error) {
+	vars := map[string]int{"x": slotA, "y": slotV}
+	for i := range exprs {
+		vars[fmt.Sprintf("y%d", i+1)] = slotV + i
+	}
+
+	fns := make([]evalFn, len(exprs))
+	for i, e := range exprs {
+		n, err := Parse(e)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't parse f%d(x,y)", i+1)
+		}
+		if fns[i], err = compile(n, vars); err != nil {
+			return nil, errors.Wrapf(err, "can't compile f%d(x,y)", i+1)
+		}
+	}
+
+	return func(x float64, y num.Vector) (num.Vector, error) {
+		if len(y) != len(fns) {
+			return nil, errors.Errorf("system of %d equations got %d values", len(fns), len(y))
+		}
+		res := make(num.Vector, len(fns))
+		for i, fn := range fns {
+			res[i] = fn(x, 0, y)
+		}
+		return res, nil
+	}, nil
+}
+
+// derivativeRe matches the derivatives of y written with primes, the number of primes is the order
+var derivativeRe = regexp.MustCompile(`^y('+)$`)
+
+// HigherOrder parses the n-th order equation in the form G(x, y, y', ..., y^(n)) = H(x, y, y', ..., y^(n)),
+// where derivatives are written with primes, e.g.
+//
+//	y'' + 0.2*y' + sin(y) = 0
+//
+// and returns the order of the equation with the function, that calculates the highest derivative y^(n)
+// from x and the vector (y, y', ..., y^(n-1)), i.e. the equation, resolved for the highest derivative
+func HigherOrder(eq string) (int, func(x float64, y num.Vector) (float64, error), error) {
+	sides := strings.Split(eq, "=")
+	if len(sides) > 2 {
+		return 0, nil, errors.New("equation must contain at most one '='")
+	}
+
+	lhs, err := Parse(sides[0])
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "can't parse equation")
+	}
+	var rhs Node = &Num{Val: 0}
+	if len(sides) == 2 {
+		// columns of the right side are counted from the start of the equation
+		offset := len(sides[0]) + 1
+		if rhs, err = Parse(sides[1]); err != nil {
+			if perr, ok := err.(*Error); ok {
+				perr.Col += offset
+			}
+			return 0, nil, errors.Wrap(err, "can't parse equation")
+		}
+		walk(rhs, func(n Node) {
+			switch n := n.(type) {
+			case *Var:
+				n.Col += offset
+			case *Call:
+				n.Col += offset
+			}
+		})
+	}
+	residual := &Binary{Op: '-', L: lhs, R: rhs}
+
+	order := 0
+	walk(residual, func(n Node) {
+		if v, ok := n.(*Var); ok {
+			if m := derivativeRe.FindStringSubmatch(v.Name); m != nil && len(m[1]) > order {
+				order = len(m[1])
+			}
+		}
+	})
+	if order == 0 {
+		return 0, nil, errors.New("equation must contain derivatives of y, written as y', y'', ...")
+	}
+
+	// y, y', ..., y^(n-1) are bound to the state vector, the highest derivative to the scalar
+	vars := map[string]int{"x": slotA, "y": slotV, "y" + strings.Repeat("'", order): slotB}
+	for k := 1; k < order; k++ {
+		vars["y"+strings.Repeat("'", k)] = slotV + k
+	}
+	fn, err := compile(residual, vars)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "can't compile equation")
+	}
+
+	f := func(x float64, y num.Vector) (float64, error) {
+		if len(y) != order {
+			return 0, errors.Errorf("equation of order %d got %d values", order, len(y))
+		}
+		return solveHighestDerivative(func(yn float64) (float64, error) { return fn(x, yn, y), nil })
+	}
+
+	return order, f, nil
+}
+
+// solveHighestDerivative finds the root of the residual of the equation. Most of the equations
+// are linear in the highest derivative, so the root is found from two evaluations, otherwise
+// the linear guess is refined with the secant method
+func solveHighestDerivative(residual func(yn float64) (float64, error)) (float64, error) {
+	const tol, maxIter = 1e-12, 50
+
+	r0, err := residual(0)
+	if err != nil {
+		return 0, err
+	}
+	r1, err := residual(1)
+	if err != nil {
+		return 0, err
+	}
+	if r1 == r0 {
+		return 0, errors.New("equation does not depend on the highest derivative")
+	}
+
+	prev, rPrev := 1.0, r1
+	cur := -r0 / (r1 - r0)
+	for i := 0; i < maxIter; i++ {
+		r, err := residual(cur)
+		if err != nil {
+			return 0, err
+		}
+		if math.Abs(r) <= tol*math.Max(1, math.Abs(cur)) {
+			return cur, nil
+		}
+		if r == rPrev {
+			break
+		}
+		prev, cur, rPrev = cur, cur-r*(cur-prev)/(r-rPrev), r
+	}
+	return 0, errors.Errorf("failed to resolve the equation for the highest derivative near %g", cur)
+}
@@ -0,0 +1,231 @@
+package expr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Semior001/decompract/app/num"
+)
+
+func TestFunc2(t *testing.T) {
+	const x, y = 0.7, -1.3
+	tbl := []struct {
+		src string
+		exp float64
+	}{
+		{"x*x - 2*y", x*x - 2*y},
+		{"1 + 2*3 - 4/2", 5},
+		{"2^3^2", 512},
+		{"2**3", 8},
+		{"-x^2", -x * x},
+		{"(-x)^2", x * x},
+		{"2^-1", 0.5},
+		{"--x", x},
+		{"+x", x},
+		{"1.5e-3 * 2E2", 0.3},
+		{"2*e", 2 * math.E},
+		{"pi + pi()", 2 * math.Pi},
+		{"y*y*exp(x) - 2*y", y*y*math.Exp(x) - 2*y},
+		{"exp(-x) / (y*exp(x) + 1)", math.Exp(-x) / (y*math.Exp(x) + 1)},
+		{"sqrt(abs(y))", math.Sqrt(math.Abs(y))},
+		{"ln(x) + log(x) + log10(x)", 2*math.Log(x) + math.Log10(x)},
+		{"sin(x) + cos(x) + tan(x)", math.Sin(x) + math.Cos(x) + math.Tan(x)},
+		{"asin(x) + acos(x) + atan(y)", math.Asin(x) + math.Acos(x) + math.Atan(y)},
+		{"sinh(y) + cosh(y) + tanh(y)", math.Sinh(y) + math.Cosh(y) + math.Tanh(y)},
+		{"atan2(y, x)", math.Atan2(y, x)},
+		{"pow(x, 3)", math.Pow(x, 3)},
+		{"min(x, y) + 10*max(x, y)", y + 10*x},
+		{"sign(y) + sign(x) + sign(0)", 0},
+	}
+	for _, tt := range tbl {
+		f, err := Func2(tt.src, "x", "y")
+		require.NoError(t, err, tt.src)
+		res, err := f(x, y)
+		require.NoError(t, err, tt.src)
+		assert.InDelta(t, tt.exp, res, 1e-12, tt.src)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tbl := []struct {
+		src string
+		err string
+	}{
+		{"", "empty expression at column 1"},
+		{"x +", "unexpected end of expression at column 4"},
+		{"sqrt(x", `unexpected end of expression, expected ")" at column 7`},
+		{"x $ y", "unexpected character '$' at column 3"},
+		{"(x + y))", `unexpected ")" at column 8`},
+		{"x y", `unexpected "y" at column 3`},
+		{"2 * foo(x)", "unknown function foo at column 5"},
+		{"x + z", "unknown variable z at column 5"},
+		{"atan2(x)", "function atan2 takes 2 argument(s), got 1 at column 1"},
+		{"pi(x)", "function pi takes 0 argument(s), got 1 at column 1"},
+	}
+	for _, tt := range tbl {
+		_, err := Func2(tt.src, "x", "y")
+		require.Error(t, err, tt.src)
+		assert.Contains(t, err.Error(), tt.err, tt.src)
+	}
+}
+
+func TestFunc2_NoAllocs(t *testing.T) {
+	f, err := Func2("y*y*exp(x) - 2*y + sqrt(abs(x))^3", "x", "y")
+	require.NoError(t, err)
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = f(0.5, 1.5)
+	})
+	assert.Equal(t, 0.0, allocs)
+}
+
+func TestSystem(t *testing.T) {
+	sys, err := System(Split("y2; -y + x*y1 ;"))
+	require.NoError(t, err)
+	res, err := sys(2, num.Vector{3, 4})
+	require.NoError(t, err)
+	assert.Equal(t, num.Vector{4, 3}, res)
+
+	_, err = sys(2, num.Vector{3})
+	assert.Error(t, err)
+
+	_, err = System([]string{"y1", "y3"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "can't compile f2(x,y): unknown variable y3 at column 1")
+}
+
+func TestHigherOrder(t *testing.T) {
+	order, f, err := HigherOrder("y'' + 0.2*y' + sin(y) = x")
+	require.NoError(t, err)
+	assert.Equal(t, 2, order)
+	res, err := f(1, num.Vector{0.5, 2})
+	require.NoError(t, err)
+	assert.InDelta(t, 1-0.2*2-math.Sin(0.5), res, 1e-12)
+
+	// nonlinear in the highest derivative
+	order, f, err = HigherOrder("y'^3 = y")
+	require.NoError(t, err)
+	assert.Equal(t, 1, order)
+	res, err = f(0, num.Vector{8})
+	require.NoError(t, err)
+	assert.InDelta(t, 2, res, 1e-9)
+
+	_, _, err = HigherOrder("y + x = 0")
+	assert.Error(t, err)
+
+	// columns on the right side are counted from the start of the equation
+	_, _, err = HigherOrder("y'' = y +")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at column 10")
+
+	_, _, err = HigherOrder("y'' = z")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown variable z at column 7")
+	_, _, err = HigherOrder("y'' = y = 0")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at most one '='")
+
+	// third order, lower derivatives are bound to the state vector in order
+	order, f, err = HigherOrder("y''' = x + 2*y'' - y' + y")
+	require.NoError(t, err)
+	assert.Equal(t, 3, order)
+	res, err = f(1, num.Vector{1, 2, 3})
+	require.NoError(t, err)
+	assert.InDelta(t, 1+6-2+1, res, 1e-12)
+	_, err = f(1, num.Vector{1, 2})
+	assert.Error(t, err)
+
+	// the highest derivative is cancelled out
+	_, f, err = HigherOrder("y'' - y'' + y' = 0")
+	require.NoError(t, err)
+	_, err = f(0, num.Vector{1, 2})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not depend on the highest derivative")
+}
+
+func TestSolveHighestDerivative(t *testing.T) {
+	calls := 0
+	res, err := solveHighestDerivative(func(yn float64) (float64, error) { calls++; return 3*yn - 6, nil })
+	require.NoError(t, err)
+	assert.InDelta(t, 2, res, 1e-12)
+	assert.Equal(t, 3, calls, "linear residual is resolved by the first guess")
+
+	// nonlinear residual is refined with the secant method
+	res, err = solveHighestDerivative(func(yn float64) (float64, error) { return yn*yn*yn + yn - 10, nil })
+	require.NoError(t, err)
+	assert.InDelta(t, 2, res, 1e-9)
+
+	// no real root
+	_, err = solveHighestDerivative(func(yn float64) (float64, error) { return yn*yn + 1, nil })
+	assert.Error(t, err)
+
+	_, err = solveHighestDerivative(func(yn float64) (float64, error) { return 0, errors.New("failed") })
+	assert.Error(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	const x, y, d = 0.3, 0.6, 1e-6
+	for _, src := range []string{
+		"x*x - 2*y", "y*y*exp(x) - 2*y", "exp(-x) / (y*exp(x) + 1)", "x^y", "y^3 - x^0.5", "pow(y, x) + 2^y",
+		"-ln(y) + log(x) - log10(x*y)", "sqrt(x + y) * abs(y - x) + sign(y)", "sin(x*y) + cos(y) - tan(x + y)",
+		"asin(y) + acos(x*y) + atan(y/x)", "sinh(y) * cosh(x) / tanh(y)", "atan2(y, x) + pi*e",
+		"min(x, y) + max(x*x, y)",
+	} {
+		f, err := Func2(src, "x", "y")
+		require.NoError(t, err, src)
+		for _, v := range []string{"x", "y"} {
+			df, err := Partial(src, "x", "y", v)
+			require.NoError(t, err, src)
+			res, err := df(x, y)
+			require.NoError(t, err, src)
+
+			dx, dy := d, 0.0
+			if v == "y" {
+				dx, dy = 0, d
+			}
+			fp, err := f(x+dx, y+dy)
+			require.NoError(t, err)
+			fm, err := f(x-dx, y-dy)
+			require.NoError(t, err)
+			assert.InDelta(t, (fp-fm)/(2*d), res, 1e-6, "d(%s)/d%s", src, v)
+		}
+	}
+
+	// simplified derivatives
+	tbl := []struct{ src, v, exp string }{
+		{"x*y^2", "y", "(x * (2 * y))"},
+		{"x*y^2", "x", "(y ^ 2)"},
+		{"3*x + 5", "x", "3"},
+		{"sin(y)", "x", "0"},
+		{"-exp(2*x)", "x", "(-(exp((2 * x)) * 2))"},
+	}
+	for _, tt := range tbl {
+		n, err := Parse(tt.src)
+		require.NoError(t, err)
+		d, err := Diff(n, tt.v)
+		require.NoError(t, err)
+		assert.Equal(t, tt.exp, d.String(), "d(%s)/d%s", tt.src, tt.v)
+	}
+}
+
+func TestTotalDerivatives(t *testing.T) {
+	// y' = x^2 - 2y, y'' = 2x - 2y', y''' = 2 - 2y''
+	derivs, err := TotalDerivatives("x*x - 2*y", "x", "y", 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(derivs))
+
+	const x, y = 0.5, 1.5
+	f := x*x - 2*y
+	y2, err := derivs[0](x, y)
+	require.NoError(t, err)
+	assert.InDelta(t, 2*x-2*f, y2, 1e-12)
+	y3, err := derivs[1](x, y)
+	require.NoError(t, err)
+	assert.InDelta(t, 2-2*(2*x-2*f), y3, 1e-12)
+
+	_, err = TotalDerivatives("x +", "x", "y", 2)
+	assert.Error(t, err)
+}
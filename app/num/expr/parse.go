@@ -0,0 +1,340 @@
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Error is the error of parsing or compiling the expression at the given column
+type Error struct {
+	Col int // 1-based column of the erroneous token
+	Msg string
+}
+
+// Error returns the message with the column
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at column %d", e.Msg, e.Col)
+}
+
+// Node is the node of the syntax tree of the expression
+type Node interface {
+	String() string
+}
+
+// Num is the numeric literal
+type Num struct {
+	Val float64
+}
+
+// Var is the variable or the named constant, e.g. x, y1, pi
+type Var struct {
+	Name string
+	Col  int
+}
+
+// Unary is the negation of the operand
+type Unary struct {
+	X Node
+}
+
+// Binary is the binary operation, Op is one of + - * / ^
+type Binary struct {
+	Op   byte
+	L, R Node
+}
+
+// Call is the call of the function from the math library
+type Call struct {
+	Func string
+	Args []Node
+	Col  int
+}
+
+func (n *Num) String() string   { return strconv.FormatFloat(n.Val, 'g', -1, 64) }
+func (n *Var) String() string   { return n.Name }
+func (n *Unary) String() string { return "(-" + n.X.String() + ")" }
+func (n *Binary) String() string {
+	return "(" + n.L.String() + " " + string(n.Op) + " " + n.R.String() + ")"
+}
+func (n *Call) String() string {
+	args := make([]string, len(n.Args))
+	for i, a := range n.Args {
+		args[i] = a.String()
+	}
+	return n.Func + "(" + strings.Join(args, ", ") + ")"
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNum
+	tokIdent
+	tokOp // one of + - * / ^ ( ) ,
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	col  int
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// lex splits the source into tokens, ** is the alias of ^, identifiers may
+// end with primes to denote derivatives, the number of primes is the order
+func lex(src string) ([]token, error) {
+	var res []token
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isDigit(c) || c == '.':
+			j := scanNumber(src, i)
+			res = append(res, token{kind: tokNum, text: src[i:j], col: i + 1})
+			i = j
+		case isLetter(c):
+			j := scanIdent(src, i)
+			res = append(res, token{kind: tokIdent, text: src[i:j], col: i + 1})
+			i = j
+		case c == '*' && i+1 < len(src) && src[i+1] == '*':
+			res = append(res, token{kind: tokOp, text: "^", col: i + 1})
+			i += 2
+		case strings.IndexByte("+-*/^(),", c) >= 0:
+			res = append(res, token{kind: tokOp, text: string(c), col: i + 1})
+			i++
+		default:
+			return nil, &Error{Col: i + 1, Msg: fmt.Sprintf("unexpected character %q", c)}
+		}
+	}
+	return append(res, token{kind: tokEOF, col: len(src) + 1}), nil
+}
+
+// scanNumber returns the end of the number, that starts at i, with its exponent, e.g. 1e-3,
+// but not the constant e in 2*e
+func scanNumber(src string, i int) int {
+	j := i
+	for j < len(src) && (isDigit(src[j]) || src[j] == '.') {
+		j++
+	}
+	if j == len(src) || src[j] != 'e' && src[j] != 'E' {
+		return j
+	}
+
+	k := j + 1
+	if k < len(src) && (src[k] == '+' || src[k] == '-') {
+		k++
+	}
+	if k == len(src) || !isDigit(src[k]) {
+		return j
+	}
+	for k < len(src) && isDigit(src[k]) {
+		k++
+	}
+	return k
+}
+
+// scanIdent returns the end of the identifier, that starts at i, with its primes
+func scanIdent(src string, i int) int {
+	j := i
+	for j < len(src) && (isLetter(src[j]) || isDigit(src[j])) {
+		j++
+	}
+	for j < len(src) && src[j] == '\'' {
+		j++
+	}
+	return j
+}
+
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' }
+
+// Parse parses the expression into the syntax tree, the grammar is
+//
+//	expr   = term {("+" | "-") term}
+//	term   = unary {("*" | "/") unary}
+//	unary  = ("-" | "+") unary | power
+//	power  = atom [("^" | "**") unary]
+//	atom   = number | ident | ident "(" [expr {"," expr}] ")" | "(" expr ")"
+//
+// so the power is right-associative and binds tighter than the unary minus, i.e. -x^2 = -(x^2)
+func Parse(src string) (Node, error) {
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	if toks[0].kind == tokEOF {
+		return nil, &Error{Col: 1, Msg: "empty expression"}
+	}
+
+	p := &parser{toks: toks}
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, &Error{Col: t.col, Msg: fmt.Sprintf("unexpected %s", t)}
+	}
+	return n, nil
+}
+
+// parser is the recursive descent parser of the tokens
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) peek() token { return p.toks[p.pos] }
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// isOp returns true if the current token is one of the given operators
+func (p *parser) isOp(ops string) bool {
+	t := p.peek()
+	return t.kind == tokOp && strings.Contains(ops, t.text)
+}
+
+func (p *parser) expect(op string) error {
+	if t := p.next(); t.kind != tokOp || t.text != op {
+		return &Error{Col: t.col, Msg: fmt.Sprintf("unexpected %s, expected %q", t, op)}
+	}
+	return nil
+}
+
+func (p *parser) expr() (Node, error) {
+	l, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("+-") {
+		op := p.next().text[0]
+		r, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		l = &Binary{Op: op, L: l, R: r}
+	}
+	return l, nil
+}
+
+func (p *parser) term() (Node, error) {
+	l, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("*/") {
+		op := p.next().text[0]
+		r, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		l = &Binary{Op: op, L: l, R: r}
+	}
+	return l, nil
+}
+
+func (p *parser) unary() (Node, error) {
+	if p.isOp("+-") {
+		op := p.next().text
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		if op == "+" {
+			return x, nil
+		}
+		return &Unary{X: x}, nil
+	}
+	return p.power()
+}
+
+func (p *parser) power() (Node, error) {
+	base, err := p.atom()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOp("^") {
+		return base, nil
+	}
+	p.next()
+	exp, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	return &Binary{Op: '^', L: base, R: exp}, nil
+}
+
+func (p *parser) atom() (Node, error) {
+	t := p.next()
+	switch {
+	case t.kind == tokNum:
+		v, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, &Error{Col: t.col, Msg: fmt.Sprintf("invalid number %s", t)}
+		}
+		return &Num{Val: v}, nil
+	case t.kind == tokIdent && p.isOp("("):
+		p.next()
+		call := &Call{Func: t.text, Col: t.col}
+		if p.isOp(")") {
+			p.next()
+			return call, nil
+		}
+		for {
+			arg, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			call.Args = append(call.Args, arg)
+			if !p.isOp(",") {
+				break
+			}
+			p.next()
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return call, nil
+	case t.kind == tokIdent:
+		return &Var{Name: t.text, Col: t.col}, nil
+	case t.kind == tokOp && t.text == "(":
+		n, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return n, nil
+	}
+	return nil, &Error{Col: t.col, Msg: fmt.Sprintf("unexpected %s", t)}
+}
+
+// walk calls fn for the node and all of its descendants
+func walk(n Node, fn func(Node)) {
+	fn(n)
+	switch n := n.(type) {
+	case *Unary:
+		walk(n.X, fn)
+	case *Binary:
+		walk(n.L, fn)
+		walk(n.R, fn)
+	case *Call:
+		for _, a := range n.Args {
+			walk(a, fn)
+		}
+	}
+}
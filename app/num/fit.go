@@ -0,0 +1,60 @@
+package num
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// Fit describes the straight line y = Slope*x + Intercept, fitted with least squares
+type Fit struct {
+	Slope       float64
+	Intercept   float64
+	SlopeStdErr float64 // standard error of the slope, zero if there are only two points
+	R2          float64 // coefficient of determination
+}
+
+// FitLine fits the straight line to the points with least squares, at least two
+// points with different abscissas are required
+func FitLine(pts []Point) (Fit, error) {
+	n := float64(len(pts))
+	if len(pts) < 2 {
+		return Fit{}, errors.Errorf("at least 2 points are required, got %d", len(pts))
+	}
+
+	var mx, my float64
+	for _, p := range pts {
+		mx += p.X
+		my += p.Y
+	}
+	mx, my = mx/n, my/n
+
+	var sxx, sxy, syy float64
+	for _, p := range pts {
+		sxx += (p.X - mx) * (p.X - mx)
+		sxy += (p.X - mx) * (p.Y - my)
+		syy += (p.Y - my) * (p.Y - my)
+	}
+	if sxx == 0 {
+		return Fit{}, errors.New("all points have the same abscissa")
+	}
+
+	res := Fit{Slope: sxy / sxx}
+	res.Intercept = my - res.Slope*mx
+
+	// residual sum of squares
+	var rss float64
+	for _, p := range pts {
+		d := p.Y - (res.Slope*p.X + res.Intercept)
+		rss += d * d
+	}
+
+	res.R2 = 1
+	if syy > 0 {
+		res.R2 = 1 - rss/syy
+	}
+	if len(pts) > 2 {
+		res.SlopeStdErr = math.Sqrt(rss / (n - 2) / sxx)
+	}
+	return res, nil
+}
@@ -0,0 +1,148 @@
+package graph
+
+import (
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// fieldSamples is the number of the segments of the direction fields along each axis
+const fieldSamples = 21
+
+// Region is the rectangle on the plane
+type Region struct {
+	XMin, XMax float64
+	YMin, YMax float64
+}
+
+// visible returns the part of the region, that could be shown on the axes of the given scales, the part,
+// that can't be shown on the log scale, is left out, if nothing is left, the range is (+Inf, -Inf),
+// so the region doesn't affect the range of the axis
+func (r Region) visible(logX, logY bool) Region {
+	positive := func(min, max float64, log bool) (float64, float64) {
+		switch {
+		case !log:
+			return min, max
+		case max <= 0:
+			return math.Inf(1), math.Inf(-1)
+		case min <= 0:
+			return max, max
+		}
+		return min, max
+	}
+	r.XMin, r.XMax = positive(r.XMin, r.XMax, logX)
+	r.YMin, r.YMax = positive(r.YMin, r.YMax, logY)
+	return r
+}
+
+// directionField is the field of the directions (dx, dy) on the plane, drawn as the segments of the same
+// length at the centers of the cells of the grid over the visible region, so it is sampled, when the
+// ranges of the axes are already set, e.g. the slope field of y' = f(x,y) is the field of (1, f(x,y))
+type directionField struct {
+	dir    func(x, y float64) (dx, dy float64, err error)
+	region Region // region, that is covered by the field
+	arrows bool   // draw the arrowheads, as the direction along the segment matters
+	style  draw.LineStyle
+}
+
+// newSlopeField makes the slope field of the equation y' = f(x,y), that covers the region
+func newSlopeField(f func(x, y float64) (float64, error), region Region) directionField {
+	return directionField{
+		dir: func(x, y float64) (dx, dy float64, err error) {
+			dy, err = f(x, y)
+			return 1, dy, err
+		},
+		region: region,
+		style:  draw.LineStyle{Color: color.Gray{Y: 160}, Width: vg.Points(1)},
+	}
+}
+
+// newVectorField makes the field of the directions of the autonomous system, that covers the region
+func newVectorField(f func(y1, y2 float64) (float64, float64, error), region Region) directionField {
+	return directionField{
+		dir:    f,
+		region: region,
+		arrows: true,
+		style:  draw.LineStyle{Color: color.Gray{Y: 160}, Width: vg.Points(1)},
+	}
+}
+
+// DataRange returns the region of the field, implements plot.DataRanger
+func (df directionField) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return df.region.XMin, df.region.XMax, df.region.YMin, df.region.YMax
+}
+
+// Plot draws the segments of the field, implements plot.Plotter, the direction is transformed to the canvas
+// by the derivatives of the transforms of the axes, so the segments have the same length on the canvas
+// regardless of the scales of the axes, the points, where the direction is not finite or zero, are skipped
+func (df directionField) Plot(c draw.Canvas, p *plot.Plot) {
+	trX, trY := p.Transforms(&c)
+	w, h := float64(c.Max.X-c.Min.X), float64(c.Max.Y-c.Min.Y)
+	half := 0.35 * math.Min(w, h) / fieldSamples
+
+	for _, x := range axisSamples(p.X, fieldSamples, true) {
+		for _, y := range axisSamples(p.Y, fieldSamples, true) {
+			fx, fy, err := df.dir(x, y)
+			if err != nil || math.IsNaN(fx+fy) || math.IsInf(fx, 0) && math.IsInf(fy, 0) {
+				continue
+			}
+
+			dx, dy := fx*axisScale(p.X, w, x), fy*axisScale(p.Y, h, y)
+			switch {
+			case math.IsInf(dy, 0):
+				// the slope is too steep to be represented, the segment is vertical
+				dx, dy = 0, math.Copysign(1, dy)
+			case math.IsInf(dx, 0):
+				dx, dy = math.Copysign(1, dx), 0
+			}
+			norm := math.Hypot(dx, dy)
+			if norm == 0 {
+				continue
+			}
+			dx, dy = half*dx/norm, half*dy/norm
+
+			cx, cy := float64(trX(x)), float64(trY(y))
+			c.StrokeLine2(df.style, vg.Length(cx-dx), vg.Length(cy-dy), vg.Length(cx+dx), vg.Length(cy+dy))
+			if !df.arrows {
+				continue
+			}
+
+			// arrowhead is made of two strokes, turned by ±30° from the backward direction
+			const sin, cos = 0.5, 0.8660254037844386
+			for _, s := range []float64{sin, -sin} {
+				hx, hy := -0.5*(dx*cos-dy*s), -0.5*(dx*s+dy*cos)
+				c.StrokeLine2(df.style, vg.Length(cx+dx), vg.Length(cy+dy), vg.Length(cx+dx+hx), vg.Length(cy+dy+hy))
+			}
+		}
+	}
+}
+
+// axisSamples returns the n values, which are evenly spaced along the axis on the canvas,
+// at the centers of n equal cells, if centers is set, or at the nodes, including the ends
+func axisSamples(axis plot.Axis, n int, centers bool) []float64 {
+	_, log := axis.Scale.(plot.LogScale)
+	res := make([]float64, n)
+	for i := range res {
+		u := float64(i) / float64(n-1)
+		if centers {
+			u = (float64(i) + 0.5) / float64(n)
+		}
+		if log {
+			res[i] = axis.Min * math.Pow(axis.Max/axis.Min, u)
+			continue
+		}
+		res[i] = axis.Min + u*(axis.Max-axis.Min)
+	}
+	return res
+}
+
+// axisScale returns the derivative of the position on the canvas of the given length by the value of the axis at v
+func axisScale(axis plot.Axis, length, v float64) float64 {
+	if _, log := axis.Scale.(plot.LogScale); log {
+		return length / (v * math.Log(axis.Max/axis.Min))
+	}
+	return length / (axis.Max - axis.Min)
+}
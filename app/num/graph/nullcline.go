@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// nullclineCells is the number of the cells of the grid along each axis, on which the nullclines are traced
+const nullclineCells = 100
+
+// nullcline is the set of the points, where f(x,y) = 0, traced by the marching squares on the grid
+// over the visible region, so it is sampled, when the ranges of the axes are already set, e.g.
+// the nullcline y1' = 0 of the system is the zero set of its first right-hand side
+type nullcline struct {
+	f      func(x, y float64) (float64, error)
+	region Region // region, that is covered by the nullcline
+	style  draw.LineStyle
+}
+
+// newNullcline makes the nullcline of f, that covers the region, drawn by the line of the given color,
+// the line is solid, as the dashes would restart at each of the short segments
+func newNullcline(f func(x, y float64) (float64, error), region Region, clr color.Color) nullcline {
+	return nullcline{f: f, region: region, style: draw.LineStyle{Color: clr, Width: vg.Points(2)}}
+}
+
+// DataRange returns the region of the nullcline, implements plot.DataRanger
+func (nc nullcline) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return nc.region.XMin, nc.region.XMax, nc.region.YMin, nc.region.YMax
+}
+
+// Thumbnail draws the line in the legend, implements plot.Thumbnailer
+func (nc nullcline) Thumbnail(c *draw.Canvas) {
+	y := c.Center().Y
+	c.StrokeLine2(nc.style, c.Min.X, y, c.Max.X, y)
+}
+
+// Plot draws the nullcline, implements plot.Plotter, the zero of f on the edge of the cell, where f changes
+// its sign, is interpolated linearly on the canvas, the zeros on the edges of the cell are joined by the
+// segments, the nodes, where f is not finite, are skipped with their cells
+func (nc nullcline) Plot(c draw.Canvas, p *plot.Plot) {
+	trX, trY := p.Transforms(&c)
+	xs := axisSamples(p.X, nullclineCells+1, false)
+	ys := axisSamples(p.Y, nullclineCells+1, false)
+
+	vals := make([][]float64, len(xs))
+	for i, x := range xs {
+		vals[i] = make([]float64, len(ys))
+		for j, y := range ys {
+			v, err := nc.f(x, y)
+			if err != nil || math.IsInf(v, 0) {
+				v = math.NaN()
+			}
+			vals[i][j] = v
+		}
+	}
+
+	type node struct {
+		x, y vg.Length
+		v    float64
+	}
+	at := func(i, j int) node { return node{x: trX(xs[i]), y: trY(ys[j]), v: vals[i][j]} }
+
+	for i := 0; i < nullclineCells; i++ {
+		for j := 0; j < nullclineCells; j++ {
+			// corners of the cell are listed counterclockwise, so the zeros are ordered along its boundary
+			corners := [4]node{at(i, j), at(i+1, j), at(i+1, j+1), at(i, j+1)}
+			var zeros []vg.Point
+			for k, a := range corners {
+				b := corners[(k+1)%4]
+				if math.IsNaN(a.v) || math.IsNaN(b.v) || (a.v > 0) == (b.v > 0) {
+					continue
+				}
+				t := vg.Length(a.v / (a.v - b.v))
+				zeros = append(zeros, vg.Point{X: a.x + t*(b.x-a.x), Y: a.y + t*(b.y-a.y)})
+			}
+
+			// the saddle cell with four zeros is resolved by joining the adjacent ones
+			for k := 0; k+1 < len(zeros); k += 2 {
+				c.StrokeLine2(nc.style, zeros[k].X, zeros[k].Y, zeros[k+1].X, zeros[k+1].Y)
+			}
+		}
+	}
+}
@@ -0,0 +1,283 @@
+package graph
+
+import (
+	"image/color"
+	"math"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/plotutil"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Legend is the placement of the legend in the plot
+type Legend string
+
+// supported placements of the legend
+const (
+	LegendBottomRight Legend = "bottom-right"
+	LegendBottomLeft  Legend = "bottom-left"
+	LegendTopRight    Legend = "top-right"
+	LegendTopLeft     Legend = "top-left"
+	LegendNone        Legend = "none"
+)
+
+// styleNone hides the line or the markers of the series
+const styleNone = "none"
+
+// dash patterns of the lines by the names of the styles
+var lineStyles = map[string][]vg.Length{
+	"solid":    {},
+	"dashed":   {vg.Points(6), vg.Points(4)},
+	"dotted":   {vg.Points(1), vg.Points(3)},
+	"dash-dot": {vg.Points(6), vg.Points(3), vg.Points(1), vg.Points(3)},
+}
+
+// shapes of the markers by the names of the styles
+var markerStyles = map[string]draw.GlyphDrawer{
+	"circle":   draw.CircleGlyph{},
+	"ring":     draw.RingGlyph{},
+	"square":   draw.SquareGlyph{},
+	"box":      draw.BoxGlyph{},
+	"triangle": draw.TriangleGlyph{},
+	"pyramid":  draw.PyramidGlyph{},
+	"cross":    draw.CrossGlyph{},
+	"plus":     draw.PlusGlyph{},
+}
+
+// Options describes the axes of the plots and the styles of the series, the zero value
+// describes the linear axes with the ranges fitted to the data and the default styles
+type Options struct {
+	LogX   bool     `json:"log_x" yaml:"log_x"`           // logarithmic x axis, the points with non-positive x are skipped
+	LogY   bool     `json:"log_y" yaml:"log_y"`           // logarithmic y axis, the points with non-positive y are skipped
+	XMin   *float64 `json:"x_min,omitempty" yaml:"x_min"` // bounds of the axes, fitted to the data if not set
+	XMax   *float64 `json:"x_max,omitempty" yaml:"x_max"`
+	YMin   *float64 `json:"y_min,omitempty" yaml:"y_min"`
+	YMax   *float64 `json:"y_max,omitempty" yaml:"y_max"`
+	Grid   bool     `json:"grid" yaml:"grid"`     // draw the grid lines at the major ticks
+	Legend Legend   `json:"legend" yaml:"legend"` // placement of the legend, bottom-right if empty
+	Styles []Style  `json:"styles" yaml:"styles"` // styles of the series in the order of the lines, the rest have the default ones
+
+	gradient bool // series are colored by the gradient without the markers by default, only the first and the last are in the legend
+}
+
+// Style describes the line and the markers of the series, the empty fields are left default,
+// i.e. the lines and the markers are cycled through the styles of plotutil
+type Style struct {
+	Line   string `json:"line" yaml:"line"`     // solid, dashed, dotted, dash-dot or none
+	Marker string `json:"marker" yaml:"marker"` // circle, ring, square, box, triangle, pyramid, cross, plus or none
+}
+
+// Validate checks the placement of the legend, the styles and the ranges of the axes,
+// the bounds of the logarithmic axes must be positive
+func (o Options) Validate() error {
+	switch o.Legend {
+	case "", LegendBottomRight, LegendBottomLeft, LegendTopRight, LegendTopLeft, LegendNone:
+	default:
+		return errors.Errorf("unknown legend placement %q, available placements: %s, %s, %s, %s, %s", o.Legend,
+			LegendBottomRight, LegendBottomLeft, LegendTopRight, LegendTopLeft, LegendNone)
+	}
+
+	for i, st := range o.Styles {
+		if _, ok := lineStyles[st.Line]; !ok && st.Line != "" && st.Line != styleNone {
+			return errors.Errorf("unknown line style %q of series %d", st.Line, i+1)
+		}
+		if _, ok := markerStyles[st.Marker]; !ok && st.Marker != "" && st.Marker != styleNone {
+			return errors.Errorf("unknown marker %q of series %d", st.Marker, i+1)
+		}
+	}
+
+	if err := checkRange("x", o.XMin, o.XMax, o.LogX); err != nil {
+		return err
+	}
+	return checkRange("y", o.YMin, o.YMax, o.LogY)
+}
+
+// checkRange checks, that the set bounds of the axis are finite and min is less than max
+func checkRange(axis string, min, max *float64, log bool) error {
+	for _, b := range []*float64{min, max} {
+		switch {
+		case b == nil:
+		case math.IsNaN(*b) || math.IsInf(*b, 0):
+			return errors.Errorf("bound %g of %s axis must be finite", *b, axis)
+		case log && *b <= 0:
+			return errors.Errorf("bound %g of the logarithmic %s axis must be positive", *b, axis)
+		}
+	}
+	if min != nil && max != nil && !(*min < *max) {
+		return errors.Errorf("%s_min=%g must be less than %s_max=%g", axis, *min, axis, *max)
+	}
+	return nil
+}
+
+// ParseStyles parses the styles of the series, separated by ';', each style consists of the names of the line
+// style and the marker in any order, separated by spaces, e.g. "dashed circle; ; no-marker", no-line and
+// no-marker hide the line and the markers, the omitted ones are left default
+func ParseStyles(s string) ([]Style, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var res []Style
+	for i, item := range strings.Split(s, ";") {
+		var st Style
+		for _, word := range strings.Fields(strings.ToLower(item)) {
+			_, line := lineStyles[word]
+			_, marker := markerStyles[word]
+			switch {
+			case word == "no-line":
+				st.Line = styleNone
+			case word == "no-marker":
+				st.Marker = styleNone
+			case line:
+				st.Line = word
+			case marker:
+				st.Marker = word
+			default:
+				return nil, errors.Errorf("unknown style %q of series %d", word, i+1)
+			}
+		}
+		res = append(res, st)
+	}
+	return res, nil
+}
+
+// style returns the style of the i-th series
+func (o Options) style(i int) Style {
+	if i < len(o.Styles) {
+		return o.Styles[i]
+	}
+	return Style{}
+}
+
+// apply sets up the scales, the grid and the placement of the legend of the plot,
+// must be called before the series are added, so the grid is drawn under them
+func (o Options) apply(p *plot.Plot) {
+	if o.LogX {
+		p.X.Scale, p.X.Tick.Marker = plot.LogScale{}, logTicks{}
+	}
+	if o.LogY {
+		p.Y.Scale, p.Y.Tick.Marker = plot.LogScale{}, logTicks{}
+	}
+	if o.Grid {
+		p.Add(plotter.NewGrid())
+	}
+
+	switch o.Legend {
+	case LegendBottomLeft:
+		p.Legend.Left = true
+	case LegendTopRight:
+		p.Legend.Top = true
+	case LegendTopLeft:
+		p.Legend.Top, p.Legend.Left = true, true
+	}
+}
+
+// fitRanges sets the bounds of the axes, must be called after the series are added, as the bounds,
+// that are not set, are fitted to them, the fitted bound is clamped to the set one, if the set one is beyond it,
+// returns error if the range is still empty
+func (o Options) fitRanges(p *plot.Plot) error {
+	p.X.Min, p.X.Max = fitRange(o.XMin, o.XMax, p.X.Min, p.X.Max)
+	p.Y.Min, p.Y.Max = fitRange(o.YMin, o.YMax, p.Y.Min, p.Y.Max)
+
+	// the degenerate range is widened by the plot to the non-positive values, e.g.
+	// when the errors are the same for all N, so it is widened multiplicatively
+	if o.LogX && p.X.Min == p.X.Max {
+		p.X.Min, p.X.Max = p.X.Min/2, p.X.Max*2
+	}
+	if o.LogY && p.Y.Min == p.Y.Max {
+		p.Y.Min, p.Y.Max = p.Y.Min/2, p.Y.Max*2
+	}
+
+	switch {
+	case p.X.Min > p.X.Max:
+		return errors.Errorf("x range [%g, %g] is empty", p.X.Min, p.X.Max)
+	case p.Y.Min > p.Y.Max:
+		return errors.Errorf("y range [%g, %g] is empty", p.Y.Min, p.Y.Max)
+	}
+	return nil
+}
+
+// fitRange returns the range of the axis with the set bounds, the fitted bound [lo, hi] is clamped
+// to the set one, e.g. the range is degenerate [min, min], if min is set above the data
+func fitRange(min, max *float64, lo, hi float64) (float64, float64) {
+	if min != nil {
+		lo = *min
+		if max == nil && hi < lo {
+			hi = lo
+		}
+	}
+	if max != nil {
+		hi = *max
+		if min == nil && lo > hi {
+			lo = hi
+		}
+	}
+	return lo, hi
+}
+
+// addSeries adds the i-th of n lines to the plot with the style from the options, the line and the markers of
+// the default styles are cycled through the plotutil's ones, or the lines are colored by the gradient and solid
+// without the markers, the series is added to the legend, if it is shown
+func (o Options) addSeries(p *plot.Plot, i, n int, name string, xys plotter.XYs) error {
+	l, s, err := plotter.NewLinePoints(xys)
+	if err != nil {
+		return err
+	}
+	l.Color, l.Dashes = plotutil.Color(i), plotutil.Dashes(i)
+	s.Color, s.Shape = plotutil.Color(i), plotutil.Shape(i)
+
+	st := o.style(i)
+	if o.gradient {
+		l.Color, l.Dashes, s.Color = gradient(i, n), nil, gradient(i, n)
+		if st.Marker == "" {
+			st.Marker = styleNone
+		}
+	}
+	if dashes, ok := lineStyles[st.Line]; ok {
+		l.Dashes = dashes
+	}
+	if shape, ok := markerStyles[st.Marker]; ok {
+		s.Shape = shape
+	}
+
+	var thumbs []plot.Thumbnailer
+	if st.Line != styleNone {
+		p.Add(l)
+		thumbs = append(thumbs, l)
+	}
+	if st.Marker != styleNone {
+		p.Add(s)
+		thumbs = append(thumbs, s)
+	}
+	if o.Legend != LegendNone && (!o.gradient || i == 0 || i == n-1) {
+		p.Legend.Add(name, thumbs...)
+	}
+	return nil
+}
+
+// stops of the gradient, i.e. the viridis colormap without its light yellow end, which is hardly seen on white
+var gradientStops = []color.RGBA{
+	{R: 0x44, G: 0x01, B: 0x54, A: 0xff},
+	{R: 0x3b, G: 0x52, B: 0x8b, A: 0xff},
+	{R: 0x21, G: 0x91, B: 0x8c, A: 0xff},
+	{R: 0x5e, G: 0xc9, B: 0x62, A: 0xff},
+}
+
+// gradient returns the color of the i-th of n series, interpolated linearly between the stops
+func gradient(i, n int) color.Color {
+	if n < 2 {
+		return gradientStops[0]
+	}
+	t := float64(i) / float64(n-1) * float64(len(gradientStops)-1)
+	k := int(t)
+	if k >= len(gradientStops)-1 {
+		return gradientStops[len(gradientStops)-1]
+	}
+	a, b, f := gradientStops[k], gradientStops[k+1], t-float64(k)
+	mix := func(u, v uint8) uint8 { return uint8(math.Round(float64(u) + f*(float64(v)-float64(u)))) }
+	return color.RGBA{R: mix(a.R, b.R), G: mix(a.G, b.G), B: mix(a.B, b.B), A: 0xff}
+}
@@ -2,11 +2,16 @@ package graph
 
 import (
 	"bytes"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
 
 	"github.com/Semior001/decompract/app/num"
 	"gonum.org/v1/plot/plotter"
-
-	"gonum.org/v1/plot/plotutil"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
 
 	"gonum.org/v1/plot/vg"
 
@@ -14,14 +19,230 @@ import (
 	"gonum.org/v1/plot"
 )
 
-const w = 10 * vg.Inch
-const h = w
+// default size and resolution of the images
+const (
+	defaultSize = 10 // inches
+	defaultDPI  = vgimg.DefaultDPI
+)
+
+// limits of the size and resolution of the images, the raster images
+// are limited in pixels, as they are kept in memory while drawing
+const (
+	maxSize   = 100 // inches
+	maxDPI    = 1200
+	maxPixels = 10000 // along each side
+)
+
+// Format is the format of the plot images
+type Format string
+
+// supported formats of the images, the vector ones are scaled without losses, e.g. in LaTeX documents
+const (
+	PNG Format = "png"
+	SVG Format = "svg"
+	PDF Format = "pdf"
+	EPS Format = "eps"
+)
+
+var mimeTypes = map[Format]string{
+	PNG: "image/png",
+	SVG: "image/svg+xml",
+	PDF: "application/pdf",
+	EPS: "application/postscript",
+}
+
+// Formats returns the names of the supported formats
+func Formats() []string {
+	return []string{string(PNG), string(SVG), string(PDF), string(EPS)}
+}
+
+// ParseFormat returns the format by its case-insensitive name, png if the name is empty
+func ParseFormat(s string) (Format, error) {
+	if s == "" {
+		return PNG, nil
+	}
+	f := Format(strings.ToLower(s))
+	if _, ok := mimeTypes[f]; !ok {
+		return "", errors.Errorf("unknown format %q, available formats: %s", s, strings.Join(Formats(), ", "))
+	}
+	return f, nil
+}
 
-// Plotter plots the image and saves it in temporary directory
-type Plotter struct{}
+// MIME returns the media type of the images in the format
+func (f Format) MIME() string {
+	return mimeTypes[f]
+}
+
+// Plotter plots the lines and encodes the plots as the images of the given format and size,
+// zero values of the fields are replaced with the defaults, i.e. 10x10 inches png with 96 DPI
+type Plotter struct {
+	Format Format  // format of the images, png if empty
+	Width  float64 // width of the images in inches, 10 if zero
+	Height float64 // height of the images in inches, equal to the width if zero
+	DPI    int     // resolution of the png images in dots per inch, ignored by the vector formats
 
-// Plot the set of lines and get the reader of the result plot
+	Options Options // axes of the plots and styles of the lines
+}
+
+// NewPlotter makes the plotter, that encodes the plots in the format of the given name with the given
+// width and height in inches and the resolution of the raster images, zero values are set to the defaults
+func NewPlotter(format string, width, height float64, dpi int) (Plotter, error) {
+	f, err := ParseFormat(format)
+	if err != nil {
+		return Plotter{}, err
+	}
+	pl := Plotter{Format: f, Width: width, Height: height, DPI: dpi}
+
+	w, h, d := pl.size()
+	switch {
+	case !(width >= 0 && w <= maxSize) || !(height >= 0 && h <= maxSize):
+		return Plotter{}, errors.Errorf("size %gx%g must be positive and not greater than %d inches", width, height, maxSize)
+	case !(dpi >= 0 && d <= maxDPI):
+		return Plotter{}, errors.Errorf("dpi=%d must be positive and not greater than %d", dpi, maxDPI)
+	case f == PNG && math.Max(w, h)*float64(d) > maxPixels:
+		return Plotter{}, errors.Errorf("png image of %gx%g inches with %d DPI exceeds %d pixels", w, h, d, maxPixels)
+	}
+	return pl, nil
+}
+
+// MIME returns the media type of the images
+func (pl *Plotter) MIME() string {
+	return pl.format().MIME()
+}
+
+// Ext returns the extension of the image files without the dot
+func (pl *Plotter) Ext() string {
+	return string(pl.format())
+}
+
+// format returns the format of the images with the default applied
+func (pl *Plotter) format() Format {
+	if pl.Format == "" {
+		return PNG
+	}
+	return pl.Format
+}
+
+// size returns the width and height of the images in inches and the DPI with the defaults applied
+func (pl *Plotter) size() (w, h float64, dpi int) {
+	w, h, dpi = pl.Width, pl.Height, pl.DPI
+	if w == 0 {
+		w = defaultSize
+	}
+	if h == 0 {
+		h = w
+	}
+	if dpi == 0 {
+		dpi = defaultDPI
+	}
+	return w, h, dpi
+}
+
+// Plot the set of lines and get the reader of the result plot, the axes and
+// the styles of the lines are set by the options of the plotter
 func (pl *Plotter) Plot(title, xTitle, yTitle string, lines []num.Line) ([]byte, error) {
+	return pl.plot(title, xTitle, yTitle, lines, nil, pl.Options)
+}
+
+// PlotLogLog plots the set of lines on the log-log axes, refs are drawn as dashed
+// lines without points, e.g. the reference slopes, the scales and the ranges of the
+// options are ignored, as the axes are fixed, the other options are applied
+func (pl *Plotter) PlotLogLog(title, xTitle, yTitle string, lines, refs []num.Line) ([]byte, error) {
+	opts := pl.Options
+	opts.LogX, opts.LogY = true, true
+	opts.XMin, opts.XMax, opts.YMin, opts.YMax = nil, nil, nil, nil
+	return pl.plot(title, xTitle, yTitle, lines, refs, opts)
+}
+
+// PlotFamily plots the family of the lines, e.g. the solutions from the different initial values, colored by the
+// gradient in the order of the lines, solid and without the markers, unless they are set by the styles, only the
+// first and the last lines are added to the legend, the axes are set by the options
+func (pl *Plotter) PlotFamily(title, xTitle, yTitle string, lines []num.Line) ([]byte, error) {
+	opts := pl.Options
+	opts.gradient = true
+	return pl.plot(title, xTitle, yTitle, lines, nil, opts)
+}
+
+// PlotSlopeField plots the slope field of the equation y' = f(x,y) under the lines, the field covers at least
+// the region and the visible parts of the lines, the axes and the styles of the lines are set by the options
+func (pl *Plotter) PlotSlopeField(title, xTitle, yTitle string, f func(x, y float64) (float64, error),
+	region Region, lines []num.Line) ([]byte, error) {
+	opts := pl.Options
+	if (opts.LogX && region.XMax <= 0) || (opts.LogY && region.YMax <= 0) {
+		// nothing to show on the log scale
+		opts.LogX, opts.LogY = false, false
+	}
+	field := newSlopeField(f, region.visible(opts.LogX, opts.LogY))
+	return pl.plot(title, xTitle, yTitle, lines, nil, opts, layer{Plotter: field})
+}
+
+// Phase describes the phase portrait of the autonomous system y1' = F1(y1,y2), y2' = F2(y1,y2)
+type Phase struct {
+	F            func(y1, y2 float64) (float64, float64, error) // right-hand sides of the system
+	Region       Region                                         // region, covered by the vector field and the nullclines
+	Trajectories []num.Line                                     // y2 against y1
+	Equilibria   []num.Point                                    // points (y1, y2), where F1 = F2 = 0
+}
+
+// PlotPhasePortrait plots the trajectories of the system on the phase plane over its vector field and the
+// nullclines y1' = 0 and y2' = 0, the equilibria are marked over the trajectories, the axes and the styles
+// of the trajectories are set by the options
+func (pl *Plotter) PlotPhasePortrait(title, xTitle, yTitle string, ph Phase) ([]byte, error) {
+	opts := pl.Options
+	if (opts.LogX && ph.Region.XMax <= 0) || (opts.LogY && ph.Region.YMax <= 0) {
+		// nothing to show on the log scale
+		opts.LogX, opts.LogY = false, false
+	}
+	region := ph.Region.visible(opts.LogX, opts.LogY)
+
+	f1 := func(y1, y2 float64) (float64, error) {
+		v, _, err := ph.F(y1, y2)
+		return v, err
+	}
+	f2 := func(y1, y2 float64) (float64, error) {
+		_, v, err := ph.F(y1, y2)
+		return v, err
+	}
+	layers := []layer{
+		{Plotter: newVectorField(ph.F, region)},
+		{Plotter: newNullcline(f1, region, color.RGBA{R: 230, G: 140, A: 255}), name: xTitle + "' = 0"},
+		{Plotter: newNullcline(f2, region, color.RGBA{G: 150, B: 150, A: 255}), name: yTitle + "' = 0"},
+	}
+
+	if eqs, _ := positive([]num.Line{{Points: ph.Equilibria}}, opts.LogX, opts.LogY); len(eqs[0].Points) > 0 {
+		s, err := plotter.NewScatter(ptsToXYs(eqs[0].Points))
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't add equilibria to plot %s", title)
+		}
+		s.GlyphStyle = draw.GlyphStyle{Color: color.Black, Shape: draw.CircleGlyph{}, Radius: vg.Points(4)}
+		layers = append(layers, layer{Plotter: s, name: "equilibria", over: true})
+	}
+
+	return pl.plot(title, xTitle, yTitle, ph.Trajectories, nil, opts, layers...)
+}
+
+// layer is the plotter, that is drawn with the lines, e.g. the slope field, it must be visible
+// on the scales of the axes of the plot, the named layers are added to the legend
+type layer struct {
+	plot.Plotter
+	name string // name in the legend, the layer is not added to the legend, if empty
+	over bool   // drawn over the lines, under them otherwise
+}
+
+// plot plots the lines with the given options, refs are drawn as gray dashed lines without points,
+// the points, that can't be shown on the log scale, are skipped, if there are no points left and
+// there are no layers, the lines are plotted on the linear axes
+func (pl *Plotter) plot(title, xTitle, yTitle string, lines, refs []num.Line, opts Options, layers ...layer) ([]byte, error) {
+	if opts.LogX || opts.LogY {
+		if shown, ok := positive(lines, opts.LogX, opts.LogY); ok || len(layers) > 0 {
+			lines = shown
+			refs, _ = positive(refs, opts.LogX, opts.LogY)
+		} else {
+			// nothing to show on the log scale
+			opts.LogX, opts.LogY = false, false
+		}
+	}
+
 	p, err := plot.New()
 	if err != nil {
 		return nil, errors.Wrap(err, "can't create new plot")
@@ -30,22 +251,99 @@ func (pl *Plotter) Plot(title, xTitle, yTitle string, lines []num.Line) ([]byte,
 	p.Title.Text = title
 	p.X.Label.Text = xTitle
 	p.Y.Label.Text = yTitle
+	opts.apply(p)
+	addLayers(p, opts, layers, false)
+
+	for i, line := range lines {
+		if err = opts.addSeries(p, i, len(lines), line.Name, ptsToXYs(line.Points)); err != nil {
+			return nil, errors.Wrapf(err, "can't add line %s to plot %s", line.Name, title)
+		}
+	}
+
+	for _, ref := range refs {
+		l, err := plotter.NewLine(ptsToXYs(ref.Points))
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't add reference line %s to plot %s", ref.Name, title)
+		}
+		l.Color = color.Gray{Y: 128}
+		l.Dashes = []vg.Length{vg.Points(6), vg.Points(4)}
+		p.Add(l)
+		if opts.Legend != LegendNone {
+			p.Legend.Add(ref.Name, l)
+		}
+	}
+
+	addLayers(p, opts, layers, true)
+
+	if err = opts.fitRanges(p); err != nil {
+		return nil, errors.Wrapf(err, "can't set ranges of plot %s", title)
+	}
 
-	// combining lines in one slice to satisfy idiotic plotutil's interface
-	var ls []interface{}
+	return pl.encode(p, title)
+}
+
+// addLayers adds the layers, that are drawn over or under the lines, to the plot and to the legend, if it is shown
+func addLayers(p *plot.Plot, opts Options, layers []layer, over bool) {
+	for _, l := range layers {
+		if l.over != over {
+			continue
+		}
+		p.Add(l.Plotter)
+		if th, ok := l.Plotter.(plot.Thumbnailer); ok && l.name != "" && opts.Legend != LegendNone {
+			p.Legend.Add(l.name, th)
+		}
+	}
+}
+
+// positive returns the lines without the points with non-positive x or y, if they are
+// shown on the log scale, returns false if there are no points left in all lines
+func positive(lines []num.Line, logX, logY bool) ([]num.Line, bool) {
+	var res []num.Line
+	ok := false
 	for _, line := range lines {
-		ls = append(ls, line.Name, ptsToXYs(line.Points))
+		var pts []num.Point
+		for _, pt := range line.Points {
+			if (!logX || pt.X > 0) && (!logY || pt.Y > 0) {
+				pts = append(pts, pt)
+			}
+		}
+		ok = ok || len(pts) > 0
+		res = append(res, num.Line{Name: line.Name, Points: pts})
 	}
+	return res, ok
+}
+
+// logTicks are the ticks of the log-scale axis with the labels, rounded
+// to three significant digits, as the powers of ten are not exact in floats
+type logTicks struct{}
 
-	if err = plotutil.AddLinePoints(p, ls...); err != nil {
-		return nil, errors.Wrapf(err, "can't add lines to plot %s", title)
+// Ticks returns the ticks in the specified range
+func (logTicks) Ticks(min, max float64) []plot.Tick {
+	ticks := plot.LogTicks{}.Ticks(min, max)
+	for i := range ticks {
+		if ticks[i].Label != "" {
+			ticks[i].Label = strconv.FormatFloat(ticks[i].Value, 'g', 3, 64)
+		}
 	}
+	return ticks
+}
 
+// encode renders the plot as the image of the plotter's format and size
+func (pl *Plotter) encode(p *plot.Plot, title string) ([]byte, error) {
 	b := &bytes.Buffer{}
 
-	wt, err := p.WriterTo(w, h, "png")
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to instantiate writer for the plot %s", title)
+	w, h, dpi := pl.size()
+	var wt io.WriterTo
+	if f := pl.format(); f == PNG {
+		// resolution could be set only for the raster canvas
+		c := vgimg.PngCanvas{Canvas: vgimg.NewWith(vgimg.UseWH(vg.Length(w)*vg.Inch, vg.Length(h)*vg.Inch), vgimg.UseDPI(dpi))}
+		p.Draw(draw.New(c))
+		wt = c
+	} else {
+		var err error
+		if wt, err = p.WriterTo(vg.Length(w)*vg.Inch, vg.Length(h)*vg.Inch, string(f)); err != nil {
+			return nil, errors.Wrapf(err, "failed to instantiate writer for the plot %s", title)
+		}
 	}
 
 	if _, err := wt.WriteTo(b); err != nil {
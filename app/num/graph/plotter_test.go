@@ -0,0 +1,320 @@
+package graph
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+
+	"github.com/Semior001/decompract/app/num"
+)
+
+func TestParseStyles(t *testing.T) {
+	styles, err := ParseStyles("dashed circle; ; Square DOTTED; no-line; no-marker")
+	require.NoError(t, err)
+	assert.Equal(t, []Style{
+		{Line: "dashed", Marker: "circle"},
+		{},
+		{Line: "dotted", Marker: "square"},
+		{Line: "none"},
+		{Marker: "none"},
+	}, styles)
+
+	styles, err = ParseStyles("  ")
+	require.NoError(t, err)
+	assert.Empty(t, styles)
+
+	_, err = ParseStyles("dashed; wavy")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown style "wavy" of series 2`)
+}
+
+func TestOptions_Validate(t *testing.T) {
+	f := func(v float64) *float64 { return &v }
+	tbl := []struct {
+		opts Options
+		err  string
+	}{
+		{Options{}, ""},
+		{Options{LogX: true, LogY: true, XMin: f(1), XMax: f(10), YMin: f(1e-9), Grid: true, Legend: LegendTopLeft,
+			Styles: []Style{{Line: "dash-dot", Marker: "none"}}}, ""},
+		{Options{Legend: "middle"}, `unknown legend placement "middle"`},
+		{Options{Styles: []Style{{}, {Line: "wavy"}}}, `unknown line style "wavy" of series 2`},
+		{Options{Styles: []Style{{Marker: "star"}}}, `unknown marker "star" of series 1`},
+		{Options{XMin: f(1), XMax: f(1)}, "x_min=1 must be less than x_max=1"},
+		{Options{LogY: true, YMin: f(0)}, "bound 0 of the logarithmic y axis must be positive"},
+		{Options{YMax: f(1), YMin: f(2)}, "y_min=2 must be less than y_max=1"},
+	}
+	for i, tt := range tbl {
+		err := tt.opts.Validate()
+		if tt.err == "" {
+			assert.NoError(t, err, "case %d", i)
+			continue
+		}
+		require.Error(t, err, "case %d", i)
+		assert.Contains(t, err.Error(), tt.err, "case %d", i)
+	}
+}
+
+func TestPlotter_Plot(t *testing.T) {
+	lines := []num.Line{
+		{Name: "a", Points: []num.Point{{X: 0, Y: 0}, {X: 1, Y: 1e-6}, {X: 2, Y: 1e-2}}},
+		{Name: "b", Points: []num.Point{{X: 0, Y: 0}, {X: 1, Y: 1e-3}, {X: 2, Y: 1}}},
+	}
+	f := func(v float64) *float64 { return &v }
+
+	tbl := []Options{
+		{},
+		{LogY: true, Grid: true, Legend: LegendTopLeft, Styles: []Style{{Line: "dotted", Marker: "none"}, {Line: "none"}}},
+		{LogX: true, LogY: true, XMin: f(0.5), YMax: f(10), Legend: LegendNone},
+		{XMin: f(-1), XMax: f(3), YMin: f(-1), YMax: f(2), Legend: LegendBottomLeft},
+	}
+	for i, opts := range tbl {
+		pl := Plotter{Width: 2, DPI: 50, Options: opts}
+		b, err := pl.Plot("title", "x", "y", lines)
+		require.NoError(t, err, "case %d", i)
+		cfg, err := png.DecodeConfig(bytes.NewReader(b))
+		require.NoError(t, err, "case %d", i)
+		assert.Equal(t, 100, cfg.Width, "case %d", i)
+		assert.Equal(t, 100, cfg.Height, "case %d", i)
+	}
+
+	// nothing to show on the log scale, the linear one is used
+	pl := Plotter{Width: 2, DPI: 50, Options: Options{LogY: true}}
+	_, err := pl.Plot("title", "x", "y", []num.Line{{Name: "zero", Points: []num.Point{{X: 0, Y: 0}, {X: 1, Y: 0}}}})
+	require.NoError(t, err)
+
+	// the set bound is beyond the data, the fitted one is clamped to it
+	for i, opts := range []Options{{XMin: f(5)}, {YMax: f(-5)}, {LogY: true, YMin: f(100)}} {
+		pl = Plotter{Width: 2, DPI: 50, Options: opts}
+		_, err = pl.Plot("title", "x", "y", lines)
+		require.NoError(t, err, "case %d", i)
+	}
+	p, err := plot.New()
+	require.NoError(t, err)
+	p.X.Min, p.X.Max, p.Y.Min, p.Y.Max = 0, 2, 1, 3
+	require.NoError(t, Options{XMin: f(5), YMax: f(0.5)}.fitRanges(p))
+	assert.Equal(t, [4]float64{5, 5, 0.5, 0.5}, [4]float64{p.X.Min, p.X.Max, p.Y.Min, p.Y.Max})
+	require.NoError(t, Options{LogY: true, YMin: f(4)}.fitRanges(p))
+	assert.Equal(t, [2]float64{2, 8}, [2]float64{p.Y.Min, p.Y.Max})
+
+	// both bounds are set in the wrong order
+	pl = Plotter{Width: 2, DPI: 50, Options: Options{XMin: f(5), XMax: f(1)}}
+	_, err = pl.Plot("title", "x", "y", lines)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "x range [5, 1] is empty")
+
+	// the ranges are ignored on the log-log axes
+	pl = Plotter{Width: 2, DPI: 50, Options: Options{XMin: f(5), Grid: true}}
+	_, err = pl.PlotLogLog("title", "x", "y", lines, []num.Line{{Name: "ref", Points: []num.Point{{X: 1, Y: 1}, {X: 2, Y: 0.5}}}})
+	require.NoError(t, err)
+}
+
+func TestPlotter_PlotSlopeField(t *testing.T) {
+	f := func(x, y float64) (float64, error) { return x - y, nil }
+	lines := []num.Line{{Name: "a", Points: []num.Point{{X: 0, Y: 1}, {X: 1, Y: 0.5}, {X: 2, Y: 1.5}}}}
+	region := Region{XMin: 0, XMax: 2, YMin: 0.5, YMax: 1.5}
+
+	for i, opts := range []Options{{}, {LogY: true, Grid: true}, {LogX: true, LogY: true}} {
+		pl := Plotter{Width: 2, DPI: 50, Options: opts}
+		_, err := pl.PlotSlopeField("title", "x", "y", f, region, lines)
+		require.NoError(t, err, "case %d", i)
+		_, err = pl.PlotSlopeField("title", "x", "y", f, region, nil)
+		require.NoError(t, err, "case %d", i)
+	}
+
+	// nothing to show on the log scale
+	pl := Plotter{Width: 2, DPI: 50, Options: Options{LogY: true}}
+	_, err := pl.PlotSlopeField("title", "x", "y", f, Region{XMin: 0, XMax: 1, YMin: -2, YMax: -1}, nil)
+	require.NoError(t, err)
+}
+
+// strokes records the segments, stroked on the canvas
+type strokes struct {
+	vg.Canvas
+	segments [][2]vg.Point
+}
+
+func (s *strokes) SetLineWidth(vg.Length)             {}
+func (s *strokes) SetLineDash([]vg.Length, vg.Length) {}
+func (s *strokes) SetColor(color.Color)               {}
+func (s *strokes) Stroke(p vg.Path) {
+	s.segments = append(s.segments, [2]vg.Point{p[0].Pos, p[len(p)-1].Pos})
+}
+
+func TestSlopeField_Plot(t *testing.T) {
+	f := func(x, y float64) (float64, error) {
+		if x > 1.9 {
+			return math.NaN(), nil
+		}
+		return x * y, nil
+	}
+
+	for _, log := range []bool{false, true} {
+		p, err := plot.New()
+		require.NoError(t, err)
+		p.X.Min, p.X.Max, p.Y.Min, p.Y.Max = 0, 2, 1, 100
+		if log {
+			p.Y.Scale = plot.LogScale{}
+		}
+
+		const w, h = 400, 300
+		rec := &strokes{}
+		c := draw.NewCanvas(rec, w, h)
+		newSlopeField(f, Region{}).Plot(c, p)
+
+		// the points with x > 1.9 are skipped
+		require.Equal(t, fieldSamples*(fieldSamples-1), len(rec.segments), "log=%v", log)
+		for _, seg := range rec.segments {
+			mid := vg.Point{X: (seg[0].X + seg[1].X) / 2, Y: (seg[0].Y + seg[1].Y) / 2}
+			x := 2 * float64(mid.X) / w
+			y := 1 + 99*float64(mid.Y)/h
+			// the slope on the canvas is divided by the scales of the axes
+			slope := float64(seg[1].Y-seg[0].Y) / float64(seg[1].X-seg[0].X)
+			dydx := slope * w / 2 * 99 / h
+			if log {
+				y = math.Pow(100, float64(mid.Y)/h)
+				dydx = slope * w / 2 * y * math.Log(100) / h
+			}
+			require.InEpsilon(t, x*y, dydx, 1e-6, "log=%v x=%g y=%g", log, x, y)
+
+			length := math.Hypot(float64(seg[1].X-seg[0].X), float64(seg[1].Y-seg[0].Y))
+			assert.InDelta(t, 0.7*h/fieldSamples, length, 1e-9)
+		}
+	}
+}
+
+func TestVectorField_Plot(t *testing.T) {
+	// rotation around the origin, the arrows are tangent to the circles and point counterclockwise
+	f := func(y1, y2 float64) (float64, float64, error) { return -y2, y1, nil }
+
+	p, err := plot.New()
+	require.NoError(t, err)
+	p.X.Min, p.X.Max, p.Y.Min, p.Y.Max = -1, 1, -1, 1
+
+	const w, h = 300, 300
+	rec := &strokes{}
+	newVectorField(f, Region{}).Plot(draw.NewCanvas(rec, w, h), p)
+
+	// each arrow is made of the segment and two strokes of the head, the zero direction at the origin is skipped
+	require.Equal(t, 3*(fieldSamples*fieldSamples-1), len(rec.segments))
+	for i := 0; i < len(rec.segments); i += 3 {
+		seg := rec.segments[i]
+		mid := vg.Point{X: (seg[0].X + seg[1].X) / 2, Y: (seg[0].Y + seg[1].Y) / 2}
+		x, y := 2*float64(mid.X)/w-1, 2*float64(mid.Y)/h-1
+		dx, dy := float64(seg[1].X-seg[0].X), float64(seg[1].Y-seg[0].Y)
+		assert.InDelta(t, 0, x*dx+y*dy, 1e-9, "x=%g y=%g", x, y)
+		assert.True(t, x*dy-y*dx > 0, "x=%g y=%g", x, y)
+
+		// heads start at the tip of the arrow
+		for _, head := range rec.segments[i+1 : i+3] {
+			assert.Equal(t, seg[1], head[0])
+		}
+	}
+}
+
+func TestNullcline_Plot(t *testing.T) {
+	f := func(x, y float64) (float64, error) {
+		if x > 2.5 {
+			return math.Inf(1), nil
+		}
+		return y - x*x, nil
+	}
+
+	for _, log := range []bool{false, true} {
+		p, err := plot.New()
+		require.NoError(t, err)
+		p.X.Min, p.X.Max, p.Y.Min, p.Y.Max = 0.5, 3, 0.1, 10
+		if log {
+			p.X.Scale, p.Y.Scale = plot.LogScale{}, plot.LogScale{}
+		}
+
+		const w, h = 400, 300
+		rec := &strokes{}
+		c := draw.NewCanvas(rec, w, h)
+		newNullcline(f, Region{}, color.Black).Plot(c, p)
+		require.NotEmpty(t, rec.segments, "log=%v", log)
+
+		trX, trY := p.Transforms(&c)
+		for _, seg := range rec.segments {
+			for _, pt := range seg {
+				// the points on the canvas are mapped back to the data by the bisection of the transforms
+				x := invert(func(v float64) float64 { return float64(trX(v)) }, float64(pt.X), p.X.Min, p.X.Max)
+				y := invert(func(v float64) float64 { return float64(trY(v)) }, float64(pt.Y), p.Y.Min, p.Y.Max)
+				assert.LessOrEqual(t, x, 2.5+2.5/nullclineCells, "log=%v", log)
+				assert.InDelta(t, x*x, y, 0.05, "log=%v x=%g y=%g", log, x, y)
+			}
+		}
+	}
+}
+
+// invert returns v from [min, max], where the increasing tr(v) = u
+func invert(tr func(v float64) float64, u, min, max float64) float64 {
+	for i := 0; i < 100; i++ {
+		mid := (min + max) / 2
+		if tr(mid) < u {
+			min = mid
+		} else {
+			max = mid
+		}
+	}
+	return (min + max) / 2
+}
+
+func TestPlotter_PlotPhasePortrait(t *testing.T) {
+	// Lotka-Volterra equations with the equilibria at (0, 0) and (1, 1)
+	ph := Phase{
+		F:      func(y1, y2 float64) (float64, float64, error) { return y1 - y1*y2, y1*y2 - y2, nil },
+		Region: Region{XMin: 0, XMax: 2, YMin: 0, YMax: 2},
+		Trajectories: []num.Line{
+			{Name: "a", Points: []num.Point{{X: 0.5, Y: 0.5}, {X: 1.5, Y: 0.7}, {X: 1.2, Y: 1.6}}},
+			{Name: "b", Points: []num.Point{{X: 0.8, Y: 0.8}, {X: 1.1, Y: 0.9}}},
+		},
+		Equilibria: []num.Point{{X: 0, Y: 0}, {X: 1, Y: 1}},
+	}
+
+	for i, opts := range []Options{{}, {LogX: true, LogY: true, Grid: true}, {Legend: LegendNone}} {
+		pl := Plotter{Width: 2, DPI: 50, Options: opts}
+		b, err := pl.PlotPhasePortrait("title", "y1", "y2", ph)
+		require.NoError(t, err, "case %d", i)
+		_, err = png.DecodeConfig(bytes.NewReader(b))
+		require.NoError(t, err, "case %d", i)
+	}
+
+	// without the trajectories and the equilibria
+	pl := Plotter{Width: 2, DPI: 50}
+	_, err := pl.PlotPhasePortrait("title", "y1", "y2", Phase{F: ph.F, Region: ph.Region})
+	require.NoError(t, err)
+}
+
+func TestGradient(t *testing.T) {
+	assert.Equal(t, gradientStops[0], gradient(0, 1))
+	assert.Equal(t, gradientStops[0], gradient(0, 7))
+	assert.Equal(t, gradientStops[3], gradient(6, 7))
+	assert.Equal(t, gradientStops[1], gradient(2, 7))
+	// halfway between the second and the third stops
+	assert.Equal(t, color.RGBA{R: 0x2e, G: 0x72, B: 0x8c, A: 0xff}, gradient(1, 3))
+}
+
+func TestPlotter_PlotFamily(t *testing.T) {
+	var lines []num.Line
+	for i := 0; i < 5; i++ {
+		y0 := float64(i) - 2
+		lines = append(lines, num.Line{Name: "y0", Points: []num.Point{{X: 0, Y: y0}, {X: 1, Y: y0 / 2}, {X: 2, Y: y0 / 4}}})
+	}
+
+	for i, opts := range []Options{{}, {LogY: true, Legend: LegendNone}, {Styles: []Style{{Line: "dashed", Marker: "circle"}}}} {
+		pl := Plotter{Width: 2, DPI: 50, Options: opts}
+		b, err := pl.PlotFamily("title", "x", "y", lines)
+		require.NoError(t, err, "case %d", i)
+		_, err = png.DecodeConfig(bytes.NewReader(b))
+		require.NoError(t, err, "case %d", i)
+	}
+}
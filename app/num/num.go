@@ -1,17 +1,29 @@
 package num
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 // Line describes a particular line on a plot
 type Line struct {
-	Name   string
-	Points []Point
+	Name   string  `json:"name"`
+	Points []Point `json:"points"`
+}
+
+// Xs returns the abscissas of the line's points
+func (l Line) Xs() []float64 {
+	xs := make([]float64, len(l.Points))
+	for i, p := range l.Points {
+		xs[i] = p.X
+	}
+	return xs
 }
 
 // Point describes a particular point on a plane
 type Point struct {
-	X float64
-	Y float64
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
 }
 
 // String implements fmt.Stringer to properly print points
@@ -19,7 +31,88 @@ func (p Point) String() string {
 	return fmt.Sprintf("(%.4f, %.4f)", p.X, p.Y)
 }
 
+// Vector describes the state of the system of equations
+type Vector []float64
+
+// VecLine describes the solution of the system of equations,
+// i.e. the set of lines, one for each component of the state
+type VecLine struct {
+	Name   string     `json:"name"`
+	Points []VecPoint `json:"points"`
+}
+
+// VecPoint describes a particular point of the solution of the system of equations
+type VecPoint struct {
+	X float64 `json:"x"`
+	Y Vector  `json:"y"`
+}
+
+// Components splits the solution of the system into separate lines,
+// one for each component of the state
+func (l VecLine) Components() []Line {
+	if len(l.Points) == 0 {
+		return nil
+	}
+
+	res := make([]Line, len(l.Points[0].Y))
+	for i := range res {
+		res[i] = Line{Name: fmt.Sprintf("%s, y%d", l.Name, i+1), Points: make([]Point, len(l.Points))}
+		for j, p := range l.Points {
+			res[i].Points[j] = Point{X: p.X, Y: p.Y[i]}
+		}
+	}
+	return res
+}
+
 // CalculateStepSize from the given number of steps
 func CalculateStepSize(n int, x0, x float64) float64 {
 	return (x - x0) / float64(n)
 }
+
+// gridTol is the relative tolerance, within which the number of steps
+// is considered to be integer despite the round-off
+const gridTol = 1e-9
+
+// GridSteps returns the number of whole steps of the size h, that fit into the interval
+// between x0 and xEnd, the ratio (xEnd-x0)/h is rounded, if it is within the round-off
+// from the integer, the step is negative, if xEnd < x0
+func GridSteps(h, x0, xEnd float64) int {
+	n, _ := gridSteps(h, x0, xEnd)
+	return n
+}
+
+// gridSteps returns the number of whole steps and whether they cover the interval exactly
+func gridSteps(h, x0, xEnd float64) (n int, exact bool) {
+	if h == 0 {
+		return 0, false
+	}
+	r := (xEnd - x0) / h
+	if !(r >= 0) {
+		return 0, false
+	}
+	if rn := math.Round(r); math.Abs(r-rn) <= gridTol*math.Max(1, r) {
+		return int(rn), true
+	}
+	return int(math.Floor(r)), false
+}
+
+// Grid returns the abscissas x_i = x0 + i*h of the uniform grid with the step size h
+// from x0 to xEnd, they are calculated from the integer index, so the round-off is
+// not accumulated, and the last one is exactly xEnd, if the interval is divided into
+// the whole number of steps, the grid goes backwards, if both h and xEnd-x0 are
+// negative, returns nil if the step doesn't lead from x0 to xEnd
+func Grid(h, x0, xEnd float64) []float64 {
+	if h == 0 || !((xEnd-x0)/h >= 0) {
+		return nil
+	}
+
+	n, exact := gridSteps(h, x0, xEnd)
+	xs := make([]float64, n+1)
+	for i := range xs {
+		xs[i] = x0 + float64(i)*h
+	}
+	if exact {
+		xs[n] = xEnd
+	}
+	return xs
+}
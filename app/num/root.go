@@ -0,0 +1,154 @@
+package num
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// maxRootIter is the max number of iterations of the root finder
+const maxRootIter = 100
+
+// ErrNotBracketed is returned by FindRoot, if f has the values of the same sign at the ends
+var ErrNotBracketed = errors.New("root is not bracketed")
+
+// FindRoot finds the root of f between a and b, where f has the values of different signs,
+// with Brent's method, which combines the bisection with the secant method and the inverse
+// quadratic interpolation, so it converges as fast as the latter and never slower than
+// the bisection, the root is found within tol
+func FindRoot(f func(x float64) (float64, error), a, b, tol float64) (float64, error) {
+	fa, err := f(a)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to calculate f for x=%.4f", a)
+	}
+	fb, err := f(b)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to calculate f for x=%.4f", b)
+	}
+	switch {
+	case fa == 0:
+		return a, nil
+	case fb == 0:
+		return b, nil
+	case !(fa*fb < 0):
+		return 0, errors.Wrapf(ErrNotBracketed, "f(a)=%g, f(b)=%g between x=%.4f and x=%.4f", fa, fb, a, b)
+	}
+
+	// b is the best approximation, c is the opposite end of the bracket,
+	// a is the previous approximation, d is the last step and e is the one before it
+	c, fc := b, fb
+	var d, e float64
+	for i := 0; i < maxRootIter; i++ {
+		if (fb > 0) == (fc > 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		tol1 := 2*2.2e-16*math.Abs(b) + tol/2
+		xm := (c - b) / 2
+		if math.Abs(xm) <= tol1 || fb == 0 {
+			return b, nil
+		}
+
+		if math.Abs(e) >= tol1 && math.Abs(fa) > math.Abs(fb) {
+			// secant or inverse quadratic interpolation
+			s := fb / fa
+			var p, q float64
+			if a == c {
+				p, q = 2*xm*s, 1-s
+			} else {
+				q, r := fa/fc, fb/fc
+				p = s * (2*xm*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = math.Abs(p)
+			if 2*p < math.Min(3*xm*q-math.Abs(tol1*q), math.Abs(e*q)) {
+				e, d = d, p/q
+			} else {
+				d, e = xm, xm
+			}
+		} else {
+			d, e = xm, xm
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > tol1 {
+			b += d
+		} else {
+			b += math.Copysign(tol1, xm)
+		}
+		if fb, err = f(b); err != nil {
+			return 0, errors.Wrapf(err, "failed to calculate f for x=%.4f", b)
+		}
+		if math.IsNaN(fb) {
+			return 0, errors.Errorf("f is not a number at x=%.4f", b)
+		}
+	}
+	return 0, errors.Errorf("root is not found in %d iterations, the last approximation is x=%.4f", maxRootIter, b)
+}
+
+// FindRoot2 finds the root of the system of two equations f(x, y) = 0 with Newton's method, started at p,
+// the Jacobian is approximated by the central differences, the root is found, when the step is within tol
+// relative to the approximation, returns error if the Jacobian is singular or the iterations diverge
+func FindRoot2(f func(x, y float64) (float64, float64, error), p Point, tol float64) (Point, error) {
+	at := func(x, y float64) (float64, float64, error) {
+		u, v, err := f(x, y)
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "failed to calculate f at %s", Point{X: x, Y: y})
+		}
+		return u, v, nil
+	}
+
+	for i := 0; i < maxRootIter; i++ {
+		u, v, err := at(p.X, p.Y)
+		if err != nil {
+			return Point{}, err
+		}
+		if u == 0 && v == 0 {
+			return p, nil
+		}
+
+		// columns of the Jacobian are the central differences by x and by y
+		hx, hy := math.Cbrt(2.2e-16)*math.Max(1, math.Abs(p.X)), math.Cbrt(2.2e-16)*math.Max(1, math.Abs(p.Y))
+		ux1, vx1, err := at(p.X+hx, p.Y)
+		if err != nil {
+			return Point{}, err
+		}
+		ux0, vx0, err := at(p.X-hx, p.Y)
+		if err != nil {
+			return Point{}, err
+		}
+		uy1, vy1, err := at(p.X, p.Y+hy)
+		if err != nil {
+			return Point{}, err
+		}
+		uy0, vy0, err := at(p.X, p.Y-hy)
+		if err != nil {
+			return Point{}, err
+		}
+		a, b := (ux1-ux0)/(2*hx), (uy1-uy0)/(2*hy)
+		c, d := (vx1-vx0)/(2*hx), (vy1-vy0)/(2*hy)
+
+		det := a*d - b*c
+		if det == 0 || math.IsNaN(det) || math.IsInf(det, 0) {
+			return Point{}, errors.Errorf("jacobian is singular at %s", p)
+		}
+		dx, dy := (u*d-b*v)/det, (a*v-c*u)/det
+		p = Point{X: p.X - dx, Y: p.Y - dy}
+		if math.IsNaN(p.X+p.Y) || math.IsInf(p.X, 0) || math.IsInf(p.Y, 0) {
+			return Point{}, errors.Errorf("iterations diverged after %d steps", i+1)
+		}
+		if math.Abs(dx) <= tol*math.Max(1, math.Abs(p.X)) && math.Abs(dy) <= tol*math.Max(1, math.Abs(p.Y)) {
+			return p, nil
+		}
+	}
+	return Point{}, errors.Errorf("root is not found in %d iterations, the last approximation is %s", maxRootIter, p)
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+
+	"github.com/Semior001/decompract/app/num/solver"
+)
+
+// parameters of the check of the exact solution
+const (
+	// number of points inside the interval, where the equation is checked
+	checkSamples = 100
+	// relative residuals above checkTol are reported, the derivative of the exact solution
+	// is calculated with the central finite difference, which is accurate to ~1e-10
+	checkTol = 1e-6
+)
+
+// Consistency describes, how well the exact solution satisfies the equation and the initial condition
+type Consistency struct {
+	Residual        float64 `json:"residual"`         // max of |y'(x) - f(x,y(x))| / max(1, |f(x,y(x))|) along the interval
+	X               float64 `json:"x"`                // abscissa of the max residual
+	Y0              float64 `json:"y0"`               // exact solution at x0, i.e. y(x0, C(x0,y0))
+	InitialResidual float64 `json:"initial_residual"` // |y(x0) - y0| / max(1, |y0|)
+}
+
+// Warnings describes the failed checks in human-readable form
+func (c Consistency) Warnings() []string {
+	var res []string
+	if !(c.InitialResidual <= checkTol) {
+		res = append(res, fmt.Sprintf("exact solution doesn't satisfy the initial condition: y(x0) = %g, "+
+			"relative residual is %.3g", c.Y0, c.InitialResidual))
+	}
+	if !(c.Residual <= checkTol) {
+		res = append(res, fmt.Sprintf("exact solution doesn't satisfy the equation: max relative residual "+
+			"of y'(x) = f(x,y(x)) is %.3g at x = %.4g", c.Residual, c.X))
+	}
+	return res
+}
+
+// CheckExact checks, that the exact solution satisfies the initial condition y(x0) = y0 and the
+// equation y'(x) = f(x,y(x)) at the points inside the interval, the numerical reference
+// solution satisfies them by construction, so it is not checked
+func (s *Service) CheckExact(x0, y0, xEnd float64) (Consistency, error) {
+	if s.F == nil || s.Reference() {
+		return Consistency{}, nil
+	}
+
+	res := Consistency{Y0: y0}
+	if exact, ok := s.ExactSolver.(*solver.Exact); ok {
+		c, err := exact.C(x0, y0)
+		if err != nil {
+			return Consistency{}, errors.Wrapf(err, "failed to calculate constant for x0=%.4f, y0=%.4f", x0, y0)
+		}
+		if res.Y0, err = exact.F(x0, c); err != nil {
+			return Consistency{}, errors.Wrapf(err, "failed to calculate y for x=%.4f, c=%.4f", x0, c)
+		}
+		res.InitialResidual = relDiff(res.Y0, y0)
+	}
+
+	// the derivative is calculated with the central difference at the midpoints of
+	// the grid, so the checked points are strictly inside the interval
+	h := (xEnd - x0) / checkSamples
+	xs := make([]float64, 0, 3*checkSamples)
+	for i := 0; i < checkSamples; i++ {
+		x := x0 + (float64(i)+0.5)*h
+		d := math.Min(math.Cbrt(2.2e-16)*math.Max(1, math.Abs(x)), math.Abs(h)/4)
+		xs = append(xs, x-d, x, x+d)
+	}
+
+	line, err := s.ExactSolver.SolveAt(x0, y0, xs)
+	if err != nil {
+		return Consistency{}, errors.Wrap(err, "can't solve with exact solution")
+	}
+
+	for i := 0; i+2 < len(line.Points); i += 3 {
+		m, p, pt := line.Points[i], line.Points[i+2], line.Points[i+1]
+		dy := (p.Y - m.Y) / (p.X - m.X)
+		f, err := s.F(pt.X, pt.Y)
+		if err != nil {
+			return Consistency{}, errors.Wrapf(err, "failed to calculate f for x=%.4f y=%.4f", pt.X, pt.Y)
+		}
+
+		r := relDiff(dy, f)
+		if math.IsNaN(r) {
+			r = math.Inf(1)
+		}
+		if r > res.Residual {
+			res.Residual, res.X = r, pt.X
+		}
+	}
+	return res, nil
+}
+
+// relDiff returns |a - b| / max(1, |b|)
+func relDiff(a, b float64) float64 {
+	return math.Abs(a-b) / math.Max(1, math.Abs(b))
+}
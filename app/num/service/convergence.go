@@ -0,0 +1,197 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/Semior001/decompract/app/num"
+	"github.com/Semior001/decompract/app/num/solver"
+)
+
+// parameters of the convergence analysis
+const (
+	// orders, estimated on the coarse and fine grids, are considered different,
+	// if they differ by more than orderTol
+	orderTol = 0.5
+	// errors below roundOffLevel, scaled by the magnitude of the solution, are
+	// comparable with the accumulated round-off errors
+	roundOffLevel = 1e-11
+)
+
+// Convergence describes the empirical order of convergence of the method, estimated
+// by fitting log(err) = p*log(h) + log(C) to the norms of global errors
+type Convergence struct {
+	Name          string  `json:"name"`
+	Order         float64 `json:"order"`          // estimated order p, zero if there are not enough points
+	StdErr        float64 `json:"std_err"`        // standard error of the estimated order
+	R2            float64 `json:"r2"`             // coefficient of determination of the fit
+	CoarseOrder   float64 `json:"coarse_order"`   // order, estimated by the coarser half of the grids
+	FineOrder     float64 `json:"fine_order"`     // order, estimated by the finer half of the grids
+	PreAsymptotic bool    `json:"pre_asymptotic"` // errors on the coarse grids are not in the asymptotic range
+	RoundOff      bool    `json:"round_off"`      // errors on the fine grids are dominated by round-off
+	Points        int     `json:"points"`         // number of grids with non-zero errors, used in the fit
+	Adaptive      bool    `json:"adaptive"`       // method chooses the steps on its own, so the order is not estimated
+}
+
+// Note describes the flags of the estimation in human-readable form
+func (c Convergence) Note() string {
+	switch {
+	case c.Adaptive:
+		return "adaptive method, order is not defined, as the errors don't depend on the number of steps"
+	case c.Points < 2:
+		return "not enough non-zero errors to estimate the order"
+	case c.RoundOff:
+		return fmt.Sprintf("round-off dominated on fine grids, order on coarse grids is %.2f", c.CoarseOrder)
+	case c.PreAsymptotic:
+		return fmt.Sprintf("pre-asymptotic on coarse grids, order on fine grids is %.2f", c.FineOrder)
+	}
+	return ""
+}
+
+// EstimateConvergence estimates the orders of convergence of the methods by the lines
+// of the norms of global errors by the number of steps, as returned by GlobalErrors,
+// the step size is h = |xEnd-x0|/N, scale is the magnitude of the solution, which
+// the round-off level is scaled by, it must be 1 for the relative errors
+func EstimateConvergence(gte []num.Line, x0, xEnd, scale float64) []Convergence {
+	res := make([]Convergence, 0, len(gte))
+	for _, line := range gte {
+		// points are (log h, log err), sorted from the coarse grids to the fine ones
+		var pts []num.Point
+		minErr := math.Inf(1)
+		for _, pt := range line.Points {
+			if pt.X <= 0 || pt.Y <= 0 {
+				continue
+			}
+			pts = append(pts, num.Point{X: math.Log(math.Abs(xEnd-x0) / pt.X), Y: math.Log(pt.Y)})
+			minErr = math.Min(minErr, pt.Y)
+		}
+		sort.Slice(pts, func(i, j int) bool { return pts[i].X > pts[j].X })
+
+		c := Convergence{Name: line.Name, Points: len(pts)}
+		fit, err := num.FitLine(pts)
+		if err != nil {
+			res = append(res, c)
+			continue
+		}
+		c.Order, c.StdErr, c.R2 = fit.Slope, fit.SlopeStdErr, fit.R2
+		c.CoarseOrder, c.FineOrder = c.Order, c.Order
+
+		// comparing orders on the coarse and fine halves of the grids,
+		// each of them requires at least two points
+		if len(pts) >= 4 {
+			coarse, errC := num.FitLine(pts[:len(pts)/2])
+			fine, errF := num.FitLine(pts[len(pts)/2:])
+			if errC == nil && errF == nil {
+				c.CoarseOrder, c.FineOrder = coarse.Slope, fine.Slope
+				// the order drops on the fine grids due to round-off,
+				// and rises on them, if the higher order terms dominate on the coarse ones
+				differ := math.Abs(c.CoarseOrder-c.FineOrder) > orderTol
+				c.RoundOff = differ && c.FineOrder < c.CoarseOrder && minErr < roundOffLevel*scale
+				c.PreAsymptotic = differ && c.CoarseOrder < c.FineOrder
+			}
+		}
+		res = append(res, c)
+	}
+	return res
+}
+
+// Convergence estimates the orders of convergence of Solvers by their norms of global errors, as returned by
+// GlobalErrors, the order of the adaptive methods is not estimated, as the number of steps only sets
+// their initial step size, so their errors don't depend on it
+func (s *Service) Convergence(gte []num.Line, x0, y0, xEnd float64) ([]Convergence, error) {
+	scale, err := s.solutionScale(gte, x0, y0, xEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	res := EstimateConvergence(gte, x0, xEnd, scale)
+	for i := range res {
+		if i < len(s.Solvers) && solver.IsAdaptive(s.Solvers[i]) {
+			res[i] = Convergence{Name: res[i].Name, Points: res[i].Points, Adaptive: true}
+		}
+	}
+	return res, nil
+}
+
+// solutionScale returns the max magnitude of the exact solution on the coarsest grid of the global errors,
+// or 1 if the solution is zero, the relative errors are already scaled, so their scale is 1
+func (s *Service) solutionScale(gte []num.Line, x0, y0, xEnd float64) (float64, error) {
+	nmin := math.Inf(1)
+	for _, line := range gte {
+		for _, pt := range line.Points {
+			nmin = math.Min(nmin, pt.X)
+		}
+	}
+	if s.Relative || math.IsInf(nmin, 1) || nmin < 1 {
+		return 1, nil
+	}
+
+	exact, err := s.ExactSolver.SolveAt(x0, y0, num.Grid(num.CalculateStepSize(int(nmin), x0, xEnd), x0, xEnd))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to calculate the exact solution")
+	}
+	scale := 0.0
+	for _, pt := range exact.Points {
+		scale = math.Max(scale, math.Abs(pt.Y))
+	}
+	if scale == 0 {
+		return 1, nil
+	}
+	return scale, nil
+}
+
+// PlotConvergence plots the lines of the norms of global errors by the number of steps
+// on the log-log axes with the reference slopes of the estimated orders
+func (s *Service) PlotConvergence(gte []num.Line, convs []Convergence) (plot []byte, err error) {
+	if plot, err = s.Plotter.PlotLogLog(s.ErrorsTitle("GTE, "+s.Norm.String()), "N", "Err", gte, referenceSlopes(gte, convs)); err != nil {
+		return nil, errors.Wrap(err, "can't plot graph")
+	}
+	return plot, nil
+}
+
+// referenceSlopes makes the lines err = C*N^(-p) for each distinct rounded order p,
+// starting from the max error on the coarsest grid among the methods of this order
+func referenceSlopes(gte []num.Line, convs []Convergence) []num.Line {
+	nmin, nmax := math.Inf(1), 0.0
+	for _, line := range gte {
+		for _, pt := range line.Points {
+			nmin, nmax = math.Min(nmin, pt.X), math.Max(nmax, pt.X)
+		}
+	}
+	if nmin <= 0 || nmax <= nmin {
+		return nil
+	}
+
+	// max errors on the coarsest grid by the rounded order
+	starts := map[int]float64{}
+	for i, c := range convs {
+		p := int(math.Round(c.Order))
+		if c.Points < 2 || p < 1 || i >= len(gte) {
+			continue
+		}
+		for _, pt := range gte[i].Points {
+			if pt.X == nmin && pt.Y > starts[p] {
+				starts[p] = pt.Y
+			}
+		}
+	}
+
+	var res []num.Line
+	for p, start := range starts {
+		if start <= 0 {
+			continue
+		}
+		res = append(res, num.Line{
+			Name: fmt.Sprintf("O(h^%d)", p),
+			Points: []num.Point{
+				{X: nmin, Y: start},
+				{X: nmax, Y: start * math.Pow(nmin/nmax, float64(p))},
+			},
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
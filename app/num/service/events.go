@@ -0,0 +1,86 @@
+package service
+
+import (
+	log "github.com/go-pkgz/lgr"
+	"github.com/pkg/errors"
+
+	"github.com/Semior001/decompract/app/num"
+	"github.com/Semior001/decompract/app/num/expr"
+	"github.com/Semior001/decompract/app/num/solver"
+)
+
+// EventSpec describes the event function g(x,y) in the problem, the event occurs,
+// when g changes its sign, e.g. "y - 0.5" for the moment, when y crosses 0.5
+type EventSpec struct {
+	G         string `json:"g" yaml:"g"`
+	Direction int    `json:"direction" yaml:"direction"` // 1 - g grows, -1 - g falls, 0 - both
+	Terminal  bool   `json:"terminal" yaml:"terminal"`   // stop the integration at the first root
+}
+
+// MethodEvents describes the roots of the event functions, located on the solution of the method
+type MethodEvents struct {
+	Name   string              `json:"name"`
+	Events []solver.EventPoint `json:"events"`
+}
+
+// ParseEvents makes the event functions from the expressions of g(x,y),
+// the expressions are used as the names of the events
+func ParseEvents(specs []EventSpec) ([]solver.Event, error) {
+	res := make([]solver.Event, 0, len(specs))
+	for i, spec := range specs {
+		if spec.Direction < -1 || spec.Direction > 1 {
+			return nil, errors.Errorf("direction %d of event %d must be -1, 0 or 1", spec.Direction, i+1)
+		}
+		g, err := expr.Func2(spec.G, "x", "y")
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't parse event %d g(x,y)", i+1)
+		}
+		res = append(res, solver.Event{Name: spec.G, G: g, Direction: spec.Direction, Terminal: spec.Terminal})
+	}
+	return res, nil
+}
+
+// LocateEvents solves the differential equation by Solvers and returns the roots of the event functions,
+// located on the solutions of each method, returns nil if there are no events
+func (s *Service) LocateEvents(stepSize, x0, y0, xEnd float64) ([]MethodEvents, error) {
+	if len(s.Events) == 0 {
+		return nil, nil
+	}
+	log.Printf("[DEBUG] starting location of events")
+	_, events, err := s.solveEvents(stepSize, x0, y0, xEnd)
+	return events, err
+}
+
+// solveEvents returns the num solutions of the differential equation with the located
+// events, the solutions are stopped at the first roots of the terminal events
+func (s *Service) solveEvents(stepSize, x0, y0, xEnd float64) ([]num.Line, []MethodEvents, error) {
+	var lines []num.Line
+	var events []MethodEvents
+	for _, slvr := range s.Solvers {
+		line, evs, err := s.solveWith(slvr, stepSize, x0, y0, xEnd)
+		if err != nil {
+			return nil, nil, err
+		}
+		lines = append(lines, line)
+		events = append(events, evs)
+	}
+	return lines, events, nil
+}
+
+// solveWith returns the num solution of the differential equation by the solver with the located
+// events, the solution is stopped at the first root of the terminal events
+func (s *Service) solveWith(slvr solver.Interface, stepSize, x0, y0, xEnd float64) (num.Line, MethodEvents, error) {
+	if len(s.Events) == 0 {
+		line, err := slvr.Solve(stepSize, x0, y0, xEnd)
+		if err != nil {
+			return num.Line{}, MethodEvents{}, errors.Wrap(err, "can't solve")
+		}
+		return line, MethodEvents{Name: line.Name}, nil
+	}
+
+	line, pts, err := solver.SolveEvents(slvr, s.F, s.Events, stepSize, x0, y0, xEnd)
+	if err != nil {
+		return num.Line{}, MethodEvents{}, errors.Wrap(err, "can't solve")
+	}
+	return line, MethodEvents{Name: line.Name, Events: pts}, nil
+}
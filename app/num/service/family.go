@@ -0,0 +1,161 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	log "github.com/go-pkgz/lgr"
+	"github.com/pkg/errors"
+
+	"github.com/Semior001/decompract/app/num"
+)
+
+// maxFamily is the max number of the solutions in the family, as each of them is solved and plotted
+const maxFamily = 100
+
+// Sweep describes the set of the initial values, either the list of the values or the range
+// of n evenly spaced values from From to To, including the ends, the zero value is the empty sweep
+type Sweep struct {
+	Values []float64 `json:"values,omitempty" yaml:"values"`
+	From   float64   `json:"from,omitempty" yaml:"from"`
+	To     float64   `json:"to,omitempty" yaml:"to"`
+	N      int       `json:"n,omitempty" yaml:"n"` // number of the values in the range
+}
+
+// Family describes the solutions of the equation from the set of the initial points by the same method
+type Family struct {
+	Method string     `json:"method"`
+	Lines  []num.Line `json:"lines"` // named by the initial values, which are swept, in the order of x0, then y0
+}
+
+// ParseSweep parses the list of the values, separated by ',', e.g. "-1, 0, 1", or the range of n values
+// in the form "from:to:n", e.g. "-1:1:5", the empty string is the empty sweep
+func ParseSweep(s string) (Sweep, error) {
+	if strings.TrimSpace(s) == "" {
+		return Sweep{}, nil
+	}
+
+	if parts := strings.Split(s, ":"); len(parts) > 1 {
+		if len(parts) != 3 {
+			return Sweep{}, errors.Errorf("range %q must be in the form from:to:n", s)
+		}
+		var res Sweep
+		var err error
+		if res.From, err = strconv.ParseFloat(strings.TrimSpace(parts[0]), 64); err != nil {
+			return Sweep{}, errors.Wrapf(err, "can't read start of range %q", s)
+		}
+		if res.To, err = strconv.ParseFloat(strings.TrimSpace(parts[1]), 64); err != nil {
+			return Sweep{}, errors.Wrapf(err, "can't read end of range %q", s)
+		}
+		if res.N, err = strconv.Atoi(strings.TrimSpace(parts[2])); err != nil {
+			return Sweep{}, errors.Wrapf(err, "can't read number of values of range %q", s)
+		}
+		return res, nil
+	}
+
+	var res Sweep
+	for i, item := range strings.Split(s, ",") {
+		v, err := strconv.ParseFloat(strings.TrimSpace(item), 64)
+		if err != nil {
+			return Sweep{}, errors.Wrapf(err, "can't read value %d", i+1)
+		}
+		res.Values = append(res.Values, v)
+	}
+	return res, nil
+}
+
+// Empty returns true if the sweep has no values, so the fixed value is used instead
+func (sw Sweep) Empty() bool {
+	return len(sw.Values) == 0 && sw.N == 0
+}
+
+// Points returns the values of the sweep, the fixed value is returned for the empty sweep
+func (sw Sweep) Points(fixed float64) ([]float64, error) {
+	switch {
+	case sw.Empty():
+		return []float64{fixed}, nil
+	case len(sw.Values) > 0 && sw.N != 0:
+		return nil, errors.New("sweep must have either the values or the range")
+	case len(sw.Values) > 0:
+		for _, v := range sw.Values {
+			if !finite(v) {
+				return nil, errors.Errorf("value %g of sweep must be finite", v)
+			}
+		}
+		return sw.Values, nil
+	case sw.N < 2 || sw.N > maxFamily:
+		return nil, errors.Errorf("number of values n=%d of range must be from 2 to %d", sw.N, maxFamily)
+	case !finite(sw.From) || !finite(sw.To) || sw.From == sw.To:
+		return nil, errors.Errorf("range from %g to %g must be finite and not empty", sw.From, sw.To)
+	}
+
+	res := make([]float64, sw.N)
+	for i := range res {
+		res[i] = sw.From + float64(i)*(sw.To-sw.From)/float64(sw.N-1)
+	}
+	return res, nil
+}
+
+// SolutionFamily solves the differential equation by the first of Solvers with n steps from each pair of the
+// initial values x0 and y0, the solutions are stopped at the first roots of the terminal events
+func (s *Service) SolutionFamily(n int, x0s, y0s []float64, xEnd float64) (Family, error) {
+	log.Printf("[DEBUG] starting calculation of family of solutions")
+	switch {
+	case len(s.Solvers) == 0:
+		return Family{}, errors.New("family of solutions requires the equation y' = f(x,y)")
+	case n <= 0:
+		return Family{}, errors.Errorf("n=%d must be positive", n)
+	case len(x0s) == 0 || len(y0s) == 0:
+		return Family{}, errors.New("family of solutions requires at least one initial point")
+	case len(x0s)*len(y0s) > maxFamily:
+		return Family{}, errors.Errorf("family of %d solutions exceeds %d solutions", len(x0s)*len(y0s), maxFamily)
+	}
+
+	var res Family
+	for _, x0 := range x0s {
+		if x0 == xEnd {
+			return Family{}, errors.Errorf("x_end=%g must differ from x0=%g", xEnd, x0)
+		}
+		for _, y0 := range y0s {
+			line, _, err := s.solveWith(s.Solvers[0], num.CalculateStepSize(n, x0, xEnd), x0, y0, xEnd)
+			if err != nil {
+				return Family{}, errors.Wrapf(err, "failed to solve from x0=%g, y0=%g", x0, y0)
+			}
+			res.Method = line.Name
+
+			var name []string
+			if len(x0s) > 1 || len(y0s) == 1 {
+				name = append(name, fmt.Sprintf("x0 = %g", x0))
+			}
+			if len(y0s) > 1 || len(x0s) == 1 {
+				name = append(name, fmt.Sprintf("y0 = %g", y0))
+			}
+
+			// the solution is cut at the first point, that is not finite, e.g. at the blow-up
+			pts := line.Points
+			for i, pt := range pts {
+				if !finite(pt.Y) {
+					pts = pts[:i]
+					break
+				}
+			}
+			res.Lines = append(res.Lines, num.Line{Name: strings.Join(name, ", "), Points: pts})
+		}
+	}
+	return res, nil
+}
+
+// PlotSolutionFamily solves the family of the solutions from each pair of the initial values x0 and y0
+// and plots it, the solutions are colored by the gradient in their order
+func (s *Service) PlotSolutionFamily(n int, x0s, y0s []float64, xEnd float64) (plot []byte, err error) {
+	fam, err := s.SolutionFamily(n, x0s, y0s, xEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	if plot, err = s.Plotter.PlotFamily("Family of solutions, "+fam.Method, "X", "Y", fam.Lines); err != nil {
+		return nil, errors.Wrap(err, "can't plot graph")
+	}
+	return plot, nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+
+	"github.com/Semior001/decompract/app/num"
+	"github.com/Semior001/decompract/app/num/graph"
+)
+
+// PlotSlopeField plots the slope field of the equation y' = f(x,y) over the region of the solutions from x0
+// to xEnd, as returned by Solutions, the field is overlaid by the solutions, if withSolutions is set
+func (s *Service) PlotSlopeField(x0, y0, xEnd float64, lines []num.Line, withSolutions bool) (plot []byte, err error) {
+	if s.F == nil {
+		return nil, errors.New("slope field requires the equation y' = f(x,y)")
+	}
+
+	region := regionOf(lines, x0, y0, xEnd)
+	if !withSolutions {
+		lines = nil
+	}
+
+	if plot, err = s.Plotter.PlotSlopeField("Slope field", "X", "Y", s.F, region, lines); err != nil {
+		return nil, errors.Wrap(err, "can't plot graph")
+	}
+	return plot, nil
+}
+
+// regionOf returns the region from x0 to xEnd, that covers the finite points of the lines and the initial point
+func regionOf(lines []num.Line, x0, y0, xEnd float64) graph.Region {
+	res := graph.Region{XMin: math.Min(x0, xEnd), XMax: math.Max(x0, xEnd), YMin: y0, YMax: y0}
+	for _, line := range lines {
+		for _, pt := range line.Points {
+			if math.IsNaN(pt.Y) || math.IsInf(pt.Y, 0) {
+				continue
+			}
+			res.YMin, res.YMax = math.Min(res.YMin, pt.Y), math.Max(res.YMax, pt.Y)
+		}
+	}
+	return res
+}
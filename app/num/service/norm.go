@@ -0,0 +1,109 @@
+package service
+
+import (
+	"math"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Semior001/decompract/app/num"
+)
+
+// Norm describes the aggregation of the errors at the points of the grid into the single number
+type Norm string
+
+// available norms
+const (
+	NormMax Norm = "max" // max of the absolute values, L∞
+	NormL1  Norm = "l1"  // discrete L1 norm, sum of |e_i|*h_i
+	NormL2  Norm = "l2"  // discrete L2 norm, sqrt of sum of e_i^2*h_i
+	NormRMS Norm = "rms" // root mean square of the errors
+	NormEnd Norm = "end" // error at the end of the interval
+)
+
+// Norms is the list of all available norms
+var Norms = []Norm{NormMax, NormL1, NormL2, NormRMS, NormEnd}
+
+// ParseNorm returns the norm by its name, the max norm is returned for the empty name
+func ParseNorm(s string) (Norm, error) {
+	if s = strings.ToLower(strings.TrimSpace(s)); s == "" {
+		return NormMax, nil
+	}
+	for _, n := range Norms {
+		if string(n) == s {
+			return n, nil
+		}
+	}
+	return "", errors.Errorf("unknown norm %s", s)
+}
+
+// String returns the human-readable name of the norm
+func (n Norm) String() string {
+	switch n {
+	case NormL1:
+		return "L1 norm"
+	case NormL2:
+		return "L2 norm"
+	case NormRMS:
+		return "RMS"
+	case NormEnd:
+		return "endpoint error"
+	}
+	return "max norm"
+}
+
+// Of calculates the norm of the errors at the points, the steps of the discrete
+// L1 and L2 norms are the distances between the neighbouring abscissas,
+// so non-uniform and backward grids are supported
+func (n Norm) Of(pts []num.Point) float64 {
+	if len(pts) == 0 {
+		return 0
+	}
+
+	res := 0.0
+	switch n {
+	case NormL1:
+		for i := 1; i < len(pts); i++ {
+			res += math.Abs(pts[i].Y) * math.Abs(pts[i].X-pts[i-1].X)
+		}
+	case NormL2:
+		for i := 1; i < len(pts); i++ {
+			res += pts[i].Y * pts[i].Y * math.Abs(pts[i].X-pts[i-1].X)
+		}
+		res = math.Sqrt(res)
+	case NormRMS:
+		for _, pt := range pts {
+			res += pt.Y * pt.Y
+		}
+		res = math.Sqrt(res / float64(len(pts)))
+	case NormEnd:
+		res = math.Abs(pts[len(pts)-1].Y)
+	default:
+		for _, pt := range pts {
+			res = math.Max(res, math.Abs(pt.Y))
+		}
+	}
+	return res
+}
+
+// Metrics contains the norms of the global errors of the method
+type Metrics struct {
+	Name string  `json:"name"`
+	Max  float64 `json:"max"`
+	L1   float64 `json:"l1"`
+	L2   float64 `json:"l2"`
+	RMS  float64 `json:"rms"`
+	End  float64 `json:"end"`
+}
+
+// metricsOf calculates all norms of the errors of the line
+func metricsOf(line num.Line) Metrics {
+	return Metrics{
+		Name: line.Name,
+		Max:  NormMax.Of(line.Points),
+		L1:   NormL1.Of(line.Points),
+		L2:   NormL2.Of(line.Points),
+		RMS:  NormRMS.Of(line.Points),
+		End:  NormEnd.Of(line.Points),
+	}
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"fmt"
+	"math"
+
+	log "github.com/go-pkgz/lgr"
+	"github.com/pkg/errors"
+
+	"github.com/Semior001/decompract/app/num"
+	"github.com/Semior001/decompract/app/num/graph"
+)
+
+// parameters of the search of the equilibria, the Newton's method is started from the centers
+// of the cells of the grid over the region, the roots within the tolerance are merged
+const (
+	equilibriumSeeds = 10 // number of the cells along each axis
+	equilibriumTol   = 1e-10
+	equilibriumMerge = 1e-6 // relative to the size of the region
+)
+
+// Phase describes the phase portrait of the autonomous system of two equations
+type Phase struct {
+	Method       string       `json:"method"`       // method, that solved the trajectories
+	Trajectories []num.Line   `json:"trajectories"` // y2 against y1 from each of the initial points
+	Equilibria   []num.Point  `json:"equilibria"`   // points (y1, y2), where y1' = y2' = 0
+	Region       graph.Region `json:"-"`            // region of the trajectories, where the equilibria are searched
+}
+
+// PhasePortrait solves the autonomous system of two equations from each of the initial points by the first of
+// SystemSolvers, the trajectories are cut at the first point, that is not finite, the equilibria are searched
+// by the Newton's method in the region of the trajectories
+func (s *Service) PhasePortrait(stepSize, x0 float64, y0s []num.Vector, xEnd float64) (Phase, error) {
+	log.Printf("[DEBUG] starting calculation of phase portrait")
+	if len(s.SystemSolvers) == 0 || s.System == nil {
+		return Phase{}, errors.New("phase portrait requires the system of equations")
+	}
+	if len(y0s) == 0 {
+		return Phase{}, errors.New("phase portrait requires at least one initial point")
+	}
+
+	var res Phase
+	for i, y0 := range y0s {
+		if len(y0) != 2 {
+			return Phase{}, errors.Errorf("phase portrait requires the system of two equations, "+
+				"initial point %d has %d components", i+1, len(y0))
+		}
+		if err := s.checkAutonomous(x0, y0, xEnd); err != nil {
+			return Phase{}, err
+		}
+
+		line, err := s.SystemSolvers[0].SolveSystem(stepSize, x0, y0, xEnd)
+		if err != nil {
+			return Phase{}, errors.Wrapf(err, "can't solve system from initial point %d", i+1)
+		}
+		res.Method = line.Name
+
+		traj := num.Line{Name: fmt.Sprintf("y0 = (%g, %g)", y0[0], y0[1])}
+		for _, pt := range line.Points {
+			if !finite(pt.Y[0]) || !finite(pt.Y[1]) {
+				break
+			}
+			traj.Points = append(traj.Points, num.Point{X: pt.Y[0], Y: pt.Y[1]})
+		}
+		res.Trajectories = append(res.Trajectories, traj)
+	}
+
+	res.Region = phaseRegion(res.Trajectories, y0s)
+	res.Equilibria = equilibria(s.phaseField(x0), res.Region)
+	return res, nil
+}
+
+// PlotPhasePortrait plots the trajectories of the autonomous system of two equations from each of the initial
+// points over its vector field and nullclines, the equilibria are marked
+func (s *Service) PlotPhasePortrait(stepSize, x0 float64, y0s []num.Vector, xEnd float64) (plot []byte, err error) {
+	ph, err := s.PhasePortrait(stepSize, x0, y0s, xEnd)
+	if err != nil {
+		return nil, err
+	}
+	return s.PlotPhase(x0, ph)
+}
+
+// PlotPhase plots the calculated phase portrait over the vector field and the nullclines of the system at x0
+func (s *Service) PlotPhase(x0 float64, ph Phase) (plot []byte, err error) {
+	gph := graph.Phase{F: s.phaseField(x0), Region: ph.Region, Trajectories: ph.Trajectories, Equilibria: ph.Equilibria}
+	if plot, err = s.Plotter.PlotPhasePortrait("Phase portrait, "+ph.Method, "y1", "y2", gph); err != nil {
+		return nil, errors.Wrap(err, "can't plot graph")
+	}
+	return plot, nil
+}
+
+// phaseField returns the right-hand side of the autonomous system of two equations on the phase plane
+func (s *Service) phaseField(x0 float64) func(y1, y2 float64) (float64, float64, error) {
+	return func(y1, y2 float64) (float64, float64, error) {
+		v, err := s.System(x0, num.Vector{y1, y2})
+		if err != nil {
+			return 0, 0, err
+		}
+		return v[0], v[1], nil
+	}
+}
+
+// checkAutonomous checks, that the right-hand side of the system at y0 is the same at x0, xEnd
+// and in the middle between them, as the phase portrait is drawn only for the autonomous systems
+func (s *Service) checkAutonomous(x0 float64, y0 num.Vector, xEnd float64) error {
+	at0, err := s.System(x0, y0)
+	if err != nil {
+		return errors.Wrapf(err, "failed to calculate F at x=%.4f, y=%v", x0, y0)
+	}
+	for _, x := range []float64{(x0 + xEnd) / 2, xEnd} {
+		at, err := s.System(x, y0)
+		if err != nil {
+			return errors.Wrapf(err, "failed to calculate F at x=%.4f, y=%v", x, y0)
+		}
+		for i := range at0 {
+			if at[i] != at0[i] && !(math.IsNaN(at[i]) && math.IsNaN(at0[i])) {
+				return errors.Errorf("phase portrait requires the autonomous system, F%d depends on x at y=%v", i+1, y0)
+			}
+		}
+	}
+	return nil
+}
+
+// phaseRegion returns the region, that covers the trajectories and the initial points
+// with the margins of the tenth of its size, the degenerate sides are widened
+func phaseRegion(trajectories []num.Line, y0s []num.Vector) graph.Region {
+	res := graph.Region{XMin: y0s[0][0], XMax: y0s[0][0], YMin: y0s[0][1], YMax: y0s[0][1]}
+	add := func(x, y float64) {
+		res.XMin, res.XMax = math.Min(res.XMin, x), math.Max(res.XMax, x)
+		res.YMin, res.YMax = math.Min(res.YMin, y), math.Max(res.YMax, y)
+	}
+	for _, y0 := range y0s {
+		add(y0[0], y0[1])
+	}
+	for _, traj := range trajectories {
+		for _, pt := range traj.Points {
+			add(pt.X, pt.Y)
+		}
+	}
+
+	pad := func(min, max float64) (float64, float64) {
+		d := (max - min) / 10
+		if d == 0 {
+			d = math.Max(1, math.Abs(min)) / 2
+		}
+		return min - d, max + d
+	}
+	res.XMin, res.XMax = pad(res.XMin, res.XMax)
+	res.YMin, res.YMax = pad(res.YMin, res.YMax)
+	return res
+}
+
+// equilibria returns the distinct roots of f in the region, found by the Newton's method
+// started at the centers of the cells of the grid over the region
+func equilibria(f func(y1, y2 float64) (float64, float64, error), region graph.Region) []num.Point {
+	w, h := region.XMax-region.XMin, region.YMax-region.YMin
+	merge := equilibriumMerge * math.Max(w, h)
+
+	var res []num.Point
+	for i := 0; i < equilibriumSeeds; i++ {
+		for j := 0; j < equilibriumSeeds; j++ {
+			seed := num.Point{
+				X: region.XMin + (float64(i)+0.5)*w/equilibriumSeeds,
+				Y: region.YMin + (float64(j)+0.5)*h/equilibriumSeeds,
+			}
+			root, err := num.FindRoot2(f, seed, equilibriumTol)
+			if err != nil {
+				// the iterations from the seeds far from the roots are expected to fail
+				continue
+			}
+			if root.X < region.XMin || root.X > region.XMax || root.Y < region.YMin || root.Y > region.YMax {
+				continue
+			}
+
+			known := false
+			for _, r := range res {
+				known = known || math.Hypot(r.X-root.X, r.Y-root.Y) <= merge
+			}
+			if !known {
+				res = append(res, root)
+			}
+		}
+	}
+	return res
+}
+
+// finite returns true if v is neither NaN nor infinite
+func finite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
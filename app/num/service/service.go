@@ -4,52 +4,275 @@ package service
 
 import (
 	"math"
+	"strings"
 
 	log "github.com/go-pkgz/lgr"
 
 	"github.com/Semior001/decompract/app/num"
+	"github.com/Semior001/decompract/app/num/expr"
 	"github.com/Semior001/decompract/app/num/graph"
 	"github.com/Semior001/decompract/app/num/solver"
 	"github.com/pkg/errors"
 )
 
-// Service provides methods to operate solvers, plot a graph, calculate errors
+// Service provides methods to operate solvers, plot a graph, calculate errors,
+// the service is not supposed to be modified after construction, so it could be
+// safely used concurrently, make a new one for each problem instead
 type Service struct {
-	Plotter     graph.Plotter
-	Solvers     []solver.Interface
-	ExactSolver solver.Interface
+	Plotter       graph.Plotter
+	Solvers       []solver.Interface
+	SystemSolvers []solver.SystemInterface
+	ExactSolver   solver.Pointwise
+	F             func(x, y float64) (float64, error)               // calculator for f(x,y) = y', used to check the exact solution
+	System        func(x float64, y num.Vector) (num.Vector, error) // right-hand side of the system, used for phase portraits
+	Relative      bool                                              // calculate the relative errors instead of the absolute ones
+	Norm          Norm                                              // norm of the global errors on the grid for GTE, max norm if empty
+	Events        []solver.Event                                    // events, located on the solutions, the terminal ones stop them
+}
+
+// ProblemSpec describes the initial value problem by the expressions and the numbers, as it is read
+// from the API requests and the problem files, if yxc and c are empty, the numerical reference
+// solution is used instead of the exact one
+type ProblemSpec struct {
+	Fxy      string   `json:"fxy" yaml:"fxy"`
+	Yxc      string   `json:"yxc" yaml:"yxc"`
+	C        string   `json:"c" yaml:"c"`
+	Methods  []string `json:"methods" yaml:"methods"`
+	X0       float64  `json:"x0" yaml:"x0"`
+	Y0       float64  `json:"y0" yaml:"y0"`
+	XEnd     float64  `json:"x_end" yaml:"x_end"`
+	N        int      `json:"n" yaml:"n"`
+	NMin     int      `json:"nmin" yaml:"nmin"`
+	NMax     int      `json:"nmax" yaml:"nmax"`
+	Relative bool     `json:"relative" yaml:"relative"` // calculate relative errors instead of absolute ones
+	Norm     string   `json:"norm" yaml:"norm"`         // norm of the global errors for GTE: max, l1, l2, rms or end
+
+	Events []EventSpec `json:"events" yaml:"events"` // event functions, the roots of which are located on the solutions
+}
+
+// Problem describes the differential equation y' = f(x,y) with its exact solution,
+// if the exact solution is not set, the numerical reference solution is used instead
+type Problem struct {
+	F      func(x, y float64) (float64, error) // calculator for f(x,y) = y'
+	Exact  solver.Pointwise
+	Derivs solver.Derivatives // analytic derivatives of f, approximated numerically by the methods if not set
+}
+
+// ParseProblem makes the problem from the expressions of f(x,y) = y', the general
+// solution y(x,c) and the constant c(x0,y0) of the exact solution, if both yxc and c
+// are empty, the problem is left without the exact solution, the derivatives of f
+// are derived symbolically
+func ParseProblem(fxy, yxc, c string) (Problem, error) {
+	f, err := expr.Func2(fxy, "x", "y")
+	if err != nil {
+		return Problem{}, errors.Wrap(err, "can't parse f(x,y)")
+	}
+	derivs, err := parseDerivatives(fxy)
+	if err != nil {
+		return Problem{}, err
+	}
+
+	switch {
+	case yxc == "" && c == "":
+		return Problem{F: f, Derivs: derivs}, nil
+	case yxc == "" || c == "":
+		return Problem{}, errors.New("both y(x,c) and c(x0,y0) must be specified for the exact solution")
+	}
+	yf, err := expr.Func2(yxc, "x", "c")
+	if err != nil {
+		return Problem{}, errors.Wrap(err, "can't parse y(x,c)")
+	}
+	cf, err := expr.Func2(c, "x0", "y0")
+	if err != nil {
+		return Problem{}, errors.Wrap(err, "can't parse c(x0,y0)")
+	}
+	return Problem{F: f, Exact: &solver.Exact{F: yf, C: cf}, Derivs: derivs}, nil
+}
+
+// parseDerivatives derives df/dy and the total derivatives of y of the 2nd and 3rd orders from f(x,y)
+func parseDerivatives(fxy string) (solver.Derivatives, error) {
+	dfdy, err := expr.Partial(fxy, "x", "y", "y")
+	if err != nil {
+		return solver.Derivatives{}, errors.Wrap(err, "can't derive df/dy")
+	}
+	total, err := expr.TotalDerivatives(fxy, "x", "y", 2)
+	if err != nil {
+		return solver.Derivatives{}, errors.Wrap(err, "can't derive the total derivatives of y")
+	}
+	return solver.Derivatives{DFDY: dfdy, Total: total}, nil
+}
+
+// New makes the service, that solves the given problem with the methods of the given names,
+// if the problem has no exact solution, the numerical reference one is used instead
+func New(p Problem, methods []string, plotter graph.Plotter) (*Service, error) {
+	if p.F == nil {
+		return nil, errors.New("problem must have the equation")
+	}
+	if p.Exact == nil {
+		p.Exact = &solver.Reference{F: p.F}
+	}
+
+	solvers, err := solver.NewSet(methods, p.F)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't make solvers")
+	}
+	for _, s := range solvers {
+		p.Derivs.Apply(s)
+	}
+
+	return &Service{Plotter: plotter, Solvers: solvers, ExactSolver: p.Exact, F: p.F}, nil
+}
+
+// NewSystem makes the service, that solves the system of equations y' = F(x,y)
+// with the methods of the given names
+func NewSystem(sys func(x float64, y num.Vector) (num.Vector, error), methods []string,
+	plotter graph.Plotter) (*Service, error) {
+	solvers, err := solver.NewSystemSet(methods, sys)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't make solvers")
+	}
+
+	return &Service{Plotter: plotter, SystemSolvers: solvers, System: sys}, nil
+}
+
+// Reference returns true if errors are calculated relative to the numerical
+// reference solution instead of the exact one
+func (s *Service) Reference() bool {
+	_, ok := s.ExactSolver.(*solver.Reference)
+	return ok
+}
+
+// ErrorsTitle returns the title of the graph of errors, marked, if the errors are
+// relative or calculated against the numerical reference solution
+func (s *Service) ErrorsTitle(title string) string {
+	var marks []string
+	if s.Relative {
+		marks = append(marks, "relative")
+	}
+	if s.Reference() {
+		marks = append(marks, "vs numerical reference")
+	}
+	if len(marks) == 0 {
+		return title
+	}
+	return title + " (" + strings.Join(marks, ", ") + ")"
+}
+
+// relativeAtol is the absolute floor of the denominator of the relative errors, it keeps
+// the errors finite, where the exact solution vanishes, and doesn't affect them, where |exact| >> relativeAtol
+const relativeAtol = 1e-12
+
+// errorOf returns the error of the num value y against the exact one, the relative
+// error is |y - exact| / (|exact| + relativeAtol)
+func (s *Service) errorOf(y, exact float64) float64 {
+	diff := math.Abs(y - exact)
+	if s.Relative {
+		return diff / (math.Abs(exact) + relativeAtol)
+	}
+	return diff
 }
 
 // solve returns the lines with the num solutions of the differential equation
 // with the given input data, without the exact solution
 func (s *Service) solve(stepSize, x0, y0, xEnd float64) ([]num.Line, error) {
-	var lines []num.Line
-	// solving equation
-	for _, slvr := range s.Solvers {
-		line, err := slvr.Solve(stepSize, x0, y0, xEnd)
-		if err != nil {
-			return nil, errors.Wrap(err, "can't solve")
+	lines, _, err := s.solveEvents(stepSize, x0, y0, xEnd)
+	return lines, err
+}
+
+// Solutions returns the num solutions of the differential equation by Solvers
+// with the given input data, the exact solution is the last line
+func (s *Service) Solutions(stepSize, x0, y0, xEnd float64) ([]num.Line, error) {
+	lines, _, err := s.SolutionsEvents(stepSize, x0, y0, xEnd)
+	return lines, err
+}
+
+// SolutionsEvents returns the num solutions of the differential equation by Solvers with the given
+// input data, the exact solution is the last line, and the roots of the event functions, located
+// on the solutions of each method, the events are nil if there are no event functions
+func (s *Service) SolutionsEvents(stepSize, x0, y0, xEnd float64) ([]num.Line, []MethodEvents, error) {
+	log.Printf("[DEBUG] starting calculation of solutions")
+	lines, events, err := s.solveEvents(stepSize, x0, y0, xEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(s.Events) == 0 {
+		events = nil
+	}
+
+	// adding exact solution up to the farthest end of the num solutions,
+	// as they may be stopped by the terminal events
+	xs := num.Grid(stepSize, x0, xEnd)
+	if end, ok := farthestEnd(lines, x0); ok && len(xs) > 0 && math.Abs(end-x0) < math.Abs(xs[len(xs)-1]-x0) {
+		for len(xs) > 1 && math.Abs(xs[len(xs)-1]-x0) >= math.Abs(end-x0) {
+			xs = xs[:len(xs)-1]
 		}
+		xs = append(xs, end)
+	}
+	line, err := s.ExactSolver.SolveAt(x0, y0, xs)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "can't solve with exact solution")
+	}
+	return append(lines, line), events, nil
+}
+
+// farthestEnd returns the end of the line, that is the farthest from x0, returns false if there are no lines
+func farthestEnd(lines []num.Line, x0 float64) (float64, bool) {
+	res, ok := x0, false
+	for _, line := range lines {
+		if len(line.Points) == 0 {
+			continue
+		}
+		if end := line.Points[len(line.Points)-1].X; !ok || math.Abs(end-x0) > math.Abs(res-x0) {
+			res, ok = end, true
+		}
+	}
+	return res, ok
+}
+
+// numeric returns the num solutions of Solvers from the lines, as returned by Solutions,
+// i.e. without the exact solution
+func (s *Service) numeric(lines []num.Line) []num.Line {
+	if len(lines) > len(s.Solvers) {
+		return lines[:len(s.Solvers)]
+	}
+	return lines
+}
 
+// PlotSolutions plots the solutions, as returned by Solutions
+func (s *Service) PlotSolutions(lines []num.Line) (plot []byte, err error) {
+	if plot, err = s.Plotter.Plot("Solutions", "X", "Y", lines); err != nil {
+		return nil, errors.Wrap(err, "can't plot graph")
+	}
+	return plot, nil
+}
+
+// SystemSolutions returns the solutions of the system of differential equations
+// by SystemSolvers with the given input data
+func (s *Service) SystemSolutions(stepSize, x0 float64, y0 num.Vector, xEnd float64) ([]num.VecLine, error) {
+	log.Printf("[DEBUG] starting calculation of solutions of the system")
+	var lines []num.VecLine
+	for _, slvr := range s.SystemSolvers {
+		line, err := slvr.SolveSystem(stepSize, x0, y0, xEnd)
+		if err != nil {
+			return nil, errors.Wrap(err, "can't solve system")
+		}
 		lines = append(lines, line)
 	}
 	return lines, nil
 }
 
-// PlotSolutions solves the differential equation by Solvers with the given input data
-func (s *Service) PlotSolutions(stepSize, x0, y0, xEnd float64) (plot []byte, err error) {
-	log.Printf("[DEBUG] starting calculation of solutions")
-	lines, err := s.solve(stepSize, x0, y0, xEnd)
+// PlotSystemSolutions solves the system of differential equations by SystemSolvers
+// with the given input data and plots each component of the state as a separate line
+func (s *Service) PlotSystemSolutions(stepSize, x0 float64, y0 num.Vector, xEnd float64) (plot []byte, err error) {
+	vecLines, err := s.SystemSolutions(stepSize, x0, y0, xEnd)
 	if err != nil {
 		return nil, err
 	}
 
-	// adding exact solution to the graph
-	line, err := s.ExactSolver.Solve(stepSize, x0, y0, xEnd)
-	if err != nil {
-		return nil, errors.Wrap(err, "can't solve with exact solution")
+	var lines []num.Line
+	for _, line := range vecLines {
+		lines = append(lines, line.Components()...)
 	}
-	lines = append(lines, line)
 
 	// plotting the solutions
 	if plot, err = s.Plotter.Plot("Solutions", "X", "Y", lines); err != nil {
@@ -59,48 +282,97 @@ func (s *Service) PlotSolutions(stepSize, x0, y0, xEnd float64) (plot []byte, er
 	return plot, nil
 }
 
-// PlotLocalErrors plots the graph of truncation errors from solvers related to the exact
-// solution
-func (s *Service) PlotLocalErrors(stepSize, x0, y0, xEnd float64) (plot []byte, err error) {
-	log.Printf("[DEBUG] starting calculation of LTE")
-	errLines, err := s.getLTE(stepSize, x0, y0, xEnd)
+// PlotLocalErrors plots the graph of local truncation errors, as returned by LocalErrors
+func (s *Service) PlotLocalErrors(errLines []num.Line) (plot []byte, err error) {
+	if plot, err = s.Plotter.Plot(s.ErrorsTitle("LTE"), "X", "Err", errLines); err != nil {
+		return nil, errors.Wrap(err, "can't plot graph")
+	}
+	return plot, nil
+}
+
+// LocalErrors returns the local truncation errors of the solvers, i.e. the errors
+// of the single steps, each of them is started from the exact solution at x_i,
+// the error is calculated at x_{i+1} = x_i + h, so there is no error at x0
+func (s *Service) LocalErrors(stepSize, x0, y0, xEnd float64) ([]num.Line, error) {
+	solLines, err := s.solve(stepSize, x0, y0, xEnd)
 	if err != nil {
 		return nil, err
 	}
+	return s.LocalErrorsOf(x0, y0, solLines)
+}
 
-	// plotting the solutions
-	if plot, err = s.Plotter.Plot("LTE", "X", "Err", errLines); err != nil {
+// LocalErrorsOf returns the local truncation errors of the solvers on the grids
+// of their solutions, as returned by Solutions
+func (s *Service) LocalErrorsOf(x0, y0 float64, solutions []num.Line) ([]num.Line, error) {
+	log.Printf("[DEBUG] starting calculation of LTE")
+	var errLines []num.Line
+	for i, line := range s.numeric(solutions) {
+		stepper, ok := s.Solvers[i].(solver.Stepper)
+		if !ok {
+			return nil, errors.Errorf("%s doesn't support single steps", line.Name)
+		}
+
+		// steps are made on the grid of the solution
+		exactLine, err := s.ExactSolver.SolveAt(x0, y0, line.Xs())
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't solve with exact solution for %s", line.Name)
+		}
+
+		exact := exactLine.Points
+		pts := []num.Point{}
+		for j := 1; j < len(exact); j++ {
+			from := j - stepper.Steps()
+			if from < 0 {
+				from = 0
+			}
+			y, err := stepper.Step(exact[j].X-exact[j-1].X, exact[from:j])
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to make a step of %s at x=%.4f", line.Name, exact[j-1].X)
+			}
+			pts = append(pts, num.Point{X: exact[j].X, Y: s.errorOf(y, exact[j].Y)})
+		}
+		errLines = append(errLines, num.Line{Name: line.Name, Points: pts})
+	}
+	return errLines, nil
+}
+
+// PlotPointwiseErrors plots the graph of global errors at each point, as returned by PointwiseErrors
+func (s *Service) PlotPointwiseErrors(errLines []num.Line) (plot []byte, err error) {
+	if plot, err = s.Plotter.Plot(s.ErrorsTitle("Global error"), "X", "Err", errLines); err != nil {
 		return nil, errors.Wrap(err, "can't plot graph")
 	}
 	return plot, nil
 }
 
-func (s *Service) getLTE(stepSize, x0, y0, xEnd float64) ([]num.Line, error) {
+// PointwiseErrors returns the global errors of the solvers at each point, i.e. the
+// differences between the num solutions and the exact solution, accumulated from x0
+func (s *Service) PointwiseErrors(stepSize, x0, y0, xEnd float64) ([]num.Line, error) {
 	solLines, err := s.solve(stepSize, x0, y0, xEnd)
 	if err != nil {
 		return nil, err
 	}
+	return s.PointwiseErrorsOf(x0, y0, solLines)
+}
 
-	// getting the exact solution
-	exactLine, err := s.ExactSolver.Solve(stepSize, x0, y0, xEnd)
-	if err != nil {
-		return nil, errors.Wrap(err, "can't solve with exact solution")
-	}
-
-	// calculating and aggregating truncation errors
+// PointwiseErrorsOf returns the global errors of the solvers at each point of their
+// solutions, as returned by Solutions
+func (s *Service) PointwiseErrorsOf(x0, y0 float64, solutions []num.Line) ([]num.Line, error) {
+	// calculating global errors at each point, the exact solution is calculated
+	// at the abscissas of each solution, as adaptive solvers produce their own grids
 	var errLines []num.Line
-	for _, line := range solLines {
+	for _, line := range s.numeric(solutions) {
+		exactLine, err := s.ExactSolver.SolveAt(x0, y0, line.Xs())
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't solve with exact solution for %s", line.Name)
+		}
+
 		if len(line.Points) != len(exactLine.Points) {
 			return nil, errors.Errorf("number of points are different for exact and %s", line.Name)
 		}
 		var pts []num.Point
 		for i := range exactLine.Points {
-			if exactLine.Points[i].X != line.Points[i].X {
-				return nil, errors.Errorf("x coord are different for exact and %s at i=%d", line.Name, i)
-			}
-
 			// calculating error by Y
-			y := math.Abs(line.Points[i].Y - exactLine.Points[i].Y)
+			y := s.errorOf(line.Points[i].Y, exactLine.Points[i].Y)
 			pts = append(pts, num.Point{X: exactLine.Points[i].X, Y: y})
 		}
 		errLines = append(errLines, num.Line{Name: line.Name, Points: pts})
@@ -108,42 +380,64 @@ func (s *Service) getLTE(stepSize, x0, y0, xEnd float64) ([]num.Line, error) {
 	return errLines, nil
 }
 
-// PlotGlobalErrors plots the graph of truncation errors
-func (s *Service) PlotGlobalErrors(nmin, nmax int, x0, y0, xEnd float64) (plot []byte, err error) {
+// ErrorMetrics returns the norms of the global errors of the solvers on the grid
+// with the given step size
+func (s *Service) ErrorMetrics(stepSize, x0, y0, xEnd float64) ([]Metrics, error) {
+	log.Printf("[DEBUG] starting calculation of error metrics")
+	lines, err := s.PointwiseErrors(stepSize, x0, y0, xEnd)
+	if err != nil {
+		return nil, err
+	}
+	return MetricsOf(lines), nil
+}
+
+// MetricsOf returns the norms of the global errors, as returned by PointwiseErrors
+func MetricsOf(errLines []num.Line) []Metrics {
+	res := make([]Metrics, 0, len(errLines))
+	for _, line := range errLines {
+		res = append(res, metricsOf(line))
+	}
+	return res
+}
+
+// GlobalErrors returns the norms of the global errors of the solvers for each
+// number of steps from nmin to nmax, the norm is set by the Norm of the service
+func (s *Service) GlobalErrors(nmin, nmax int, x0, y0, xEnd float64) ([]num.Line, error) {
 	log.Printf("[DEBUG] starting calculation of GTE")
-	gtes := map[string]num.Line{}
+	var gtes []num.Line
 	for i := 0; i <= nmax-nmin; i++ {
 		n := nmin + i
 
-		lines, err := s.getLTE(num.CalculateStepSize(n, x0, xEnd), x0, y0, xEnd)
+		lines, err := s.PointwiseErrors(num.CalculateStepSize(n, x0, xEnd), x0, y0, xEnd)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to calculate LTEs for n=%d", n)
+			return nil, errors.Wrapf(err, "failed to calculate errors for n=%d", n)
 		}
 
-		// looking for the max errors
-		for _, line := range lines {
-			mxErr := 0.0
-			for _, pt := range line.Points {
-				mxErr = math.Max(mxErr, pt.Y)
-			}
-
-			gte, ok := gtes[line.Name]
-			if !ok {
-				gte = num.Line{Name: line.Name, Points: []num.Point{}}
+		if gtes == nil {
+			gtes = make([]num.Line, len(lines))
+			for j, line := range lines {
+				gtes[j] = num.Line{Name: line.Name, Points: []num.Point{}}
 			}
+		}
 
-			gte.Points = append(gte.Points, num.Point{X: float64(n), Y: mxErr})
-			gtes[line.Name] = gte
+		for j, line := range lines {
+			gtes[j].Points = append(gtes[j].Points, num.Point{X: float64(n), Y: s.Norm.Of(line.Points)})
 		}
 	}
 
-	var errLines []num.Line
-	for _, line := range gtes {
-		errLines = append(errLines, line)
-	}
+	return gtes, nil
+}
 
-	if plot, err = s.Plotter.Plot("GTE", "N", "Err", errLines); err != nil {
-		return nil, errors.Wrap(err, "can't plot graph")
+// PlotGlobalErrors plots the graph of the norms of global errors, set by the Norm, by the number of steps
+// on the log-log axes with the reference slopes of the estimated orders
+func (s *Service) PlotGlobalErrors(nmin, nmax int, x0, y0, xEnd float64) (plot []byte, err error) {
+	errLines, err := s.GlobalErrors(nmin, nmax, x0, y0, xEnd)
+	if err != nil {
+		return nil, err
 	}
-	return plot, nil
+	convs, err := s.Convergence(errLines, x0, y0, xEnd)
+	if err != nil {
+		return nil, err
+	}
+	return s.PlotConvergence(errLines, convs)
 }
@@ -0,0 +1,623 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Semior001/decompract/app/num"
+	"github.com/Semior001/decompract/app/num/graph"
+	"github.com/Semior001/decompract/app/num/solver"
+)
+
+func TestEstimateConvergence(t *testing.T) {
+	// err(N) for N from 10 to 100 on [0, 1]
+	line := func(name string, err func(h float64) float64) num.Line {
+		l := num.Line{Name: name}
+		for n := 10; n <= 100; n++ {
+			l.Points = append(l.Points, num.Point{X: float64(n), Y: err(1 / float64(n))})
+		}
+		return l
+	}
+
+	convs := EstimateConvergence([]num.Line{
+		line("clean", func(h float64) float64 { return 3 * math.Pow(h, 4) }),
+		// ~h^2 on the coarse grids and ~h^4 on the fine ones
+		line("pre-asymptotic", func(h float64) float64 { return math.Pow(h, 4) / (math.Pow(h, 2) + 1e-3) }),
+		line("round-off", func(h float64) float64 { return math.Pow(h, 6) + 5e-12 }),
+		line("zero", func(h float64) float64 { return 0 }),
+	}, 0, 1, 1)
+	require.Equal(t, 4, len(convs))
+
+	assert.Equal(t, "clean", convs[0].Name)
+	assert.InDelta(t, 4, convs[0].Order, 1e-9)
+	assert.InDelta(t, 1, convs[0].R2, 1e-9)
+	assert.False(t, convs[0].PreAsymptotic)
+	assert.False(t, convs[0].RoundOff)
+	assert.Equal(t, 91, convs[0].Points)
+	assert.Empty(t, convs[0].Note())
+
+	assert.True(t, convs[1].PreAsymptotic)
+	assert.False(t, convs[1].RoundOff)
+	assert.Less(t, convs[1].CoarseOrder, convs[1].FineOrder)
+	assert.Contains(t, convs[1].Note(), "pre-asymptotic")
+
+	assert.True(t, convs[2].RoundOff)
+	assert.False(t, convs[2].PreAsymptotic)
+	assert.Contains(t, convs[2].Note(), "round-off")
+
+	assert.Equal(t, 0, convs[3].Points)
+	assert.Equal(t, 0.0, convs[3].Order)
+	assert.NotEmpty(t, convs[3].Note())
+
+	// the order drops on the fine grids, but the errors are far above the round-off
+	// of the solution of 1e-3, and the coarse order above the fine one is not pre-asymptotic
+	convs = EstimateConvergence([]num.Line{
+		line("round-off", func(h float64) float64 { return math.Pow(h, 6) + 5e-12 }),
+		line("higher order terms", func(h float64) float64 { return math.Pow(h, 2) + 1e6*math.Pow(h, 6) }),
+	}, 0, 1, 1e-3)
+	require.Equal(t, 2, len(convs))
+	for _, c := range convs {
+		assert.Greater(t, c.CoarseOrder, c.FineOrder+orderTol, c.Name)
+		assert.False(t, c.RoundOff, c.Name)
+		assert.False(t, c.PreAsymptotic, c.Name)
+		assert.Empty(t, c.Note(), c.Name)
+	}
+}
+
+func TestService_Convergence(t *testing.T) {
+	// y' = x^2 - 2y
+	p := Problem{
+		F: func(x, y float64) (float64, error) { return x*x - 2.0*y, nil },
+		Exact: &solver.Exact{
+			F: func(x, c float64) (float64, error) { return c*math.Exp(-2*x) + x*x/2 - x/2 + 0.25, nil },
+			C: func(x0, y0 float64) (float64, error) { return (y0 - x0*x0/2 + x0/2 - 0.25) / math.Exp(-2*x0), nil },
+		},
+	}
+	srv, err := New(p, []string{solver.MethodRK4, solver.MethodImprovedEuler, solver.MethodEuler}, graph.Plotter{})
+	require.NoError(t, err)
+
+	gte, err := srv.GlobalErrors(10, 40, 0, 1, 1)
+	require.NoError(t, err)
+	convs := EstimateConvergence(gte, 0, 1, 1)
+	require.Equal(t, 3, len(convs))
+	for i, order := range []float64{4, 2, 1} {
+		assert.InDelta(t, order, convs[i].Order, 0.1, convs[i].Name)
+		assert.False(t, convs[i].PreAsymptotic, convs[i].Name)
+		assert.False(t, convs[i].RoundOff, convs[i].Name)
+	}
+
+	refs := referenceSlopes(gte, convs)
+	require.Equal(t, 3, len(refs))
+	assert.Equal(t, "O(h^1)", refs[0].Name)
+	assert.Equal(t, 10.0, refs[0].Points[0].X)
+	assert.InDelta(t, refs[0].Points[0].Y/4, refs[0].Points[1].Y, 1e-12)
+
+	plot, err := srv.PlotConvergence(gte, convs)
+	require.NoError(t, err)
+	assert.NotEmpty(t, plot)
+
+	// backwards from y(1) to x = 0, the orders are the same, the errors grow
+	// in this direction, so the grids are finer to be in the asymptotic range
+	y1 := 0.25 + 0.75*math.Exp(-2)
+	gte, err = srv.GlobalErrors(40, 80, 1, y1, 0)
+	require.NoError(t, err)
+	convs = EstimateConvergence(gte, 1, 0, 1)
+	require.Equal(t, 3, len(convs))
+	for i, order := range []float64{4, 2, 1} {
+		assert.InDelta(t, order, convs[i].Order, 0.1, "backward %s", convs[i].Name)
+	}
+
+	lines, err := srv.PointwiseErrors(num.CalculateStepSize(10, 1, 0), 1, y1, 0)
+	require.NoError(t, err)
+	for _, l := range lines {
+		require.Equal(t, 11, len(l.Points), l.Name)
+		assert.Equal(t, 0.0, l.Points[10].X, l.Name)
+		assert.Greater(t, l.Points[10].Y, 0.0, l.Name)
+	}
+}
+
+func TestService_ConvergenceAdaptive(t *testing.T) {
+	// y' = x^2 - 2y
+	p := Problem{
+		F: func(x, y float64) (float64, error) { return x*x - 2.0*y, nil },
+		Exact: &solver.Exact{
+			F: func(x, c float64) (float64, error) { return c*math.Exp(-2*x) + x*x/2 - x/2 + 0.25, nil },
+			C: func(x0, y0 float64) (float64, error) { return (y0 - x0*x0/2 + x0/2 - 0.25) / math.Exp(-2*x0), nil },
+		},
+	}
+	srv, err := New(p, []string{solver.MethodRK4, solver.MethodDormandPrince}, graph.Plotter{})
+	require.NoError(t, err)
+
+	gte, err := srv.GlobalErrors(10, 40, 0, 1, 1)
+	require.NoError(t, err)
+	convs, err := srv.Convergence(gte, 0, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(convs))
+	assert.InDelta(t, 4, convs[0].Order, 0.1)
+	assert.False(t, convs[0].Adaptive)
+
+	// the number of steps only sets the initial step size of the adaptive method, so its order is not fitted
+	assert.Equal(t, "Dormand-Prince's method", convs[1].Name)
+	assert.True(t, convs[1].Adaptive)
+	assert.Equal(t, 0.0, convs[1].Order)
+	assert.Equal(t, 0.0, convs[1].R2)
+	assert.Contains(t, convs[1].Note(), "order is not defined")
+
+	refs := referenceSlopes(gte, convs)
+	require.Equal(t, 1, len(refs))
+	assert.Equal(t, "O(h^4)", refs[0].Name)
+}
+
+func TestNorm_Of(t *testing.T) {
+	// non-uniform grid, errors are signed to check the absolute values
+	pts := []num.Point{{X: 0, Y: 1}, {X: 0.5, Y: -2}, {X: 1.5, Y: 3}}
+	tbl := []struct {
+		norm Norm
+		exp  float64
+	}{
+		{NormMax, 3},
+		{NormL1, 2*0.5 + 3*1},
+		{NormL2, math.Sqrt(4*0.5 + 9*1)},
+		{NormRMS, math.Sqrt((1 + 4 + 9) / 3.0)},
+		{NormEnd, 3},
+	}
+	for _, tt := range tbl {
+		assert.InDelta(t, tt.exp, tt.norm.Of(pts), 1e-12, tt.norm.String())
+		assert.Equal(t, 0.0, tt.norm.Of(nil), tt.norm.String())
+	}
+
+	// backward grid, the steps are taken by the absolute value
+	back := []num.Point{{X: 1.5, Y: 1}, {X: 1, Y: -2}, {X: 0, Y: 3}}
+	assert.InDelta(t, 2*0.5+3*1, NormL1.Of(back), 1e-12)
+	assert.InDelta(t, math.Sqrt(4*0.5+9*1), NormL2.Of(back), 1e-12)
+
+	n, err := ParseNorm(" L2 ")
+	require.NoError(t, err)
+	assert.Equal(t, NormL2, n)
+	n, err = ParseNorm("")
+	require.NoError(t, err)
+	assert.Equal(t, NormMax, n)
+	_, err = ParseNorm("l3")
+	assert.Error(t, err)
+}
+
+func TestService_ErrorMetrics(t *testing.T) {
+	// y' = -y, the solution decays to 1e-7 at x = 16
+	p := Problem{
+		F: func(x, y float64) (float64, error) { return -y, nil },
+		Exact: &solver.Exact{
+			F: func(x, c float64) (float64, error) { return c * math.Exp(-x), nil },
+			C: func(x0, y0 float64) (float64, error) { return y0 * math.Exp(x0), nil },
+		},
+	}
+	srv, err := New(p, []string{solver.MethodEuler}, graph.Plotter{})
+	require.NoError(t, err)
+
+	abs, err := srv.ErrorMetrics(num.CalculateStepSize(160, 0, 16), 0, 1, 16)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(abs))
+	assert.Equal(t, "Euler's method", abs[0].Name)
+
+	srv.Relative = true
+	rel, err := srv.ErrorMetrics(num.CalculateStepSize(160, 0, 16), 0, 1, 16)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rel))
+
+	// (1-h)^N against exp(-x) at the end of the interval
+	expRel := math.Abs(math.Pow(0.9, 160)-math.Exp(-16)) / (math.Exp(-16) + relativeAtol)
+	assert.InDelta(t, expRel, rel[0].End, 1e-6)
+	assert.Less(t, abs[0].End, 1e-7)
+	assert.Greater(t, rel[0].End, 1e6*abs[0].End)
+	assert.LessOrEqual(t, rel[0].RMS, rel[0].Max)
+
+	// the relative error is finite where the exact solution vanishes
+	assert.InDelta(t, 1e9, srv.errorOf(1e-3, 0), 1e-3)
+	assert.InDelta(t, 0.5, srv.errorOf(3, 2), 1e-12)
+
+	// GTE is calculated in the chosen norm
+	srv.Norm = NormEnd
+	gte, err := srv.GlobalErrors(160, 160, 0, 1, 16)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(gte))
+	assert.InDelta(t, rel[0].End, gte[0].Points[0].Y, 1e-12)
+	assert.Equal(t, "GTE (relative)", srv.ErrorsTitle("GTE"))
+}
+
+func TestService_PointwiseErrors_Grid(t *testing.T) {
+	// y' = -y, y(-4) = 1, Euler's method gives (1-h)^n at x = 4
+	p := Problem{
+		F: func(x, y float64) (float64, error) { return -y, nil },
+		Exact: &solver.Exact{
+			F: func(x, c float64) (float64, error) { return c * math.Exp(-x), nil },
+			C: func(x0, y0 float64) (float64, error) { return y0 * math.Exp(x0), nil },
+		},
+	}
+	srv, err := New(p, []string{solver.MethodEuler, solver.MethodBDF2, solver.MethodTaylor2}, graph.Plotter{})
+	require.NoError(t, err)
+
+	for n := 3; n <= 200; n++ {
+		h := num.CalculateStepSize(n, -4, 4)
+		lines, err := srv.PointwiseErrors(h, -4, 1, 4)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(lines))
+		for _, l := range lines {
+			require.Equal(t, n+1, len(l.Points), "%s, n=%d", l.Name, n)
+			assert.Equal(t, 4.0, l.Points[n].X, "%s, n=%d", l.Name, n)
+		}
+		assert.InDelta(t, math.Abs(math.Pow(1-h, float64(n))-math.Exp(-8)), lines[0].Points[n].Y, 1e-12, "n=%d", n)
+	}
+}
+
+func TestService_Events(t *testing.T) {
+	// y' = -y, y(0) = 1, the solution crosses 0.5 at ln 2
+	p, err := ParseProblem("-y", "c*exp(-x)", "y0*exp(x0)")
+	require.NoError(t, err)
+	srv, err := New(p, []string{solver.MethodRK4, solver.MethodEuler}, graph.Plotter{})
+	require.NoError(t, err)
+
+	events, err := srv.LocateEvents(0.1, 0, 1, 2)
+	require.NoError(t, err)
+	assert.Nil(t, events)
+
+	srv.Events, err = ParseEvents([]EventSpec{{G: "y - 0.5", Terminal: true}, {G: "x - 0.3"}})
+	require.NoError(t, err)
+	events, err = srv.LocateEvents(0.1, 0, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, "Runge-Kutta's method", events[0].Name)
+	require.Equal(t, 2, len(events[0].Events))
+	assert.Equal(t, "x - 0.3", events[0].Events[0].Event)
+	assert.InDelta(t, 0.3, events[0].Events[0].X, 1e-12)
+	assert.InDelta(t, math.Exp(-0.3), events[0].Events[0].Y, 1e-6)
+	assert.Equal(t, "y - 0.5", events[0].Events[1].Event)
+	assert.True(t, events[0].Events[1].Terminal)
+	assert.InDelta(t, math.Ln2, events[0].Events[1].X, 1e-6)
+
+	// solutions are stopped at the terminal event, the exact one is calculated up to the farthest of them
+	lines, err := srv.Solutions(0.1, 0, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(lines))
+	for i, ev := range events {
+		last := lines[i].Points[len(lines[i].Points)-1]
+		assert.Equal(t, ev.Events[len(ev.Events)-1].X, last.X, ev.Name)
+	}
+	exact := lines[2].Points
+	assert.Equal(t, lines[0].Points[len(lines[0].Points)-1].X, exact[len(exact)-1].X)
+	assert.InDelta(t, 0.6, exact[len(exact)-2].X, 1e-12)
+
+	errs, err := srv.PointwiseErrors(0.1, 0, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, len(lines[0].Points), len(errs[0].Points))
+
+	// errors and events derived from the single solution are the same as the separately calculated ones
+	sols, solEvents, err := srv.SolutionsEvents(0.1, 0, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, lines, sols)
+	assert.Equal(t, events, solEvents)
+	derived, err := srv.PointwiseErrorsOf(0, 1, sols)
+	require.NoError(t, err)
+	assert.Equal(t, errs, derived)
+	metrics, err := srv.ErrorMetrics(0.1, 0, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, metrics, MetricsOf(derived))
+	ltes, err := srv.LocalErrors(0.1, 0, 1, 2)
+	require.NoError(t, err)
+	derived, err = srv.LocalErrorsOf(0, 1, sols)
+	require.NoError(t, err)
+	assert.Equal(t, ltes, derived)
+
+	for _, specs := range [][]EventSpec{{{G: "y +"}}, {{G: "y", Direction: 2}}} {
+		_, err = ParseEvents(specs)
+		assert.Error(t, err)
+	}
+}
+
+func TestParseProblem_Derivatives(t *testing.T) {
+	p, err := ParseProblem("x*x - 2*y", "c*exp(-2*x) + x*x/2 - x/2 + 0.25", "(y0 - x0*x0/2 + x0/2 - 0.25) / exp(-2*x0)")
+	require.NoError(t, err)
+	require.NotNil(t, p.Derivs.DFDY)
+	require.Equal(t, 2, len(p.Derivs.Total))
+	dfdy, err := p.Derivs.DFDY(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, -2.0, dfdy)
+
+	// derivatives are provided to the methods, that use them
+	srv, err := New(p, []string{solver.MethodTaylor3, solver.MethodBackwardEuler}, graph.Plotter{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(srv.Solvers[0].(*solver.Taylor).Derivs))
+	assert.NotNil(t, srv.Solvers[1].(*solver.BackwardEuler).DFDY)
+
+	gte, err := srv.GlobalErrors(10, 40, 0, 1, 1)
+	require.NoError(t, err)
+	convs := EstimateConvergence(gte, 0, 1, 1)
+	assert.InDelta(t, 3, convs[0].Order, 0.1)
+	assert.InDelta(t, 1, convs[1].Order, 0.1)
+}
+
+func TestService_CheckExact(t *testing.T) {
+	// 8th variant
+	const fxy, yxc, c = "y*y*exp(x) - 2*y", "exp(-x) / (c*exp(x) + 1)", "(exp(-x0) - y0) / (y0 * exp(x0))"
+	tbl := []struct {
+		name, yxc, c string
+		warnings     []string
+	}{
+		{name: "correct", yxc: yxc, c: c},
+		{name: "typo in y(x,c)", yxc: "exp(-x) / (c*exp(x) - 1)", c: c,
+			warnings: []string{"doesn't satisfy the initial condition", "doesn't satisfy the equation"}},
+		{name: "typo in c(x0,y0)", yxc: yxc, c: "(exp(-x0) - y0) / (y0 * exp(2*x0))",
+			warnings: []string{"doesn't satisfy the initial condition"}},
+		{name: "wrong equation", yxc: "exp(-x) / (c*exp(2*x) + 1)", c: "(exp(-x0) - y0) / (y0 * exp(2*x0))",
+			warnings: []string{"doesn't satisfy the equation"}},
+	}
+	for _, tt := range tbl {
+		p, err := ParseProblem(fxy, tt.yxc, tt.c)
+		require.NoError(t, err, tt.name)
+		srv, err := New(p, []string{solver.MethodEuler}, graph.Plotter{})
+		require.NoError(t, err, tt.name)
+
+		cons, err := srv.CheckExact(-4, 1, 4)
+		require.NoError(t, err, tt.name)
+		warnings := cons.Warnings()
+		require.Equal(t, len(tt.warnings), len(warnings), "%s: %v", tt.name, warnings)
+		for i, w := range tt.warnings {
+			assert.Contains(t, warnings[i], w, tt.name)
+		}
+		if len(tt.warnings) == 0 {
+			assert.Less(t, cons.Residual, checkTol, tt.name)
+			assert.InDelta(t, 1, cons.Y0, 1e-12, tt.name)
+		}
+	}
+
+	// the numerical reference solution is not checked
+	p, err := ParseProblem(fxy, "", "")
+	require.NoError(t, err)
+	srv, err := New(p, []string{solver.MethodEuler}, graph.Plotter{})
+	require.NoError(t, err)
+	cons, err := srv.CheckExact(-4, 1, 4)
+	require.NoError(t, err)
+	assert.Empty(t, cons.Warnings())
+}
+
+func TestService_PlotSlopeField(t *testing.T) {
+	p, err := ParseProblem("-y", "c*exp(-x)", "y0*exp(x0)")
+	require.NoError(t, err)
+	srv, err := New(p, []string{solver.MethodRK4}, graph.Plotter{Width: 2, DPI: 50})
+	require.NoError(t, err)
+
+	sols, err := srv.Solutions(0.1, 0, 1, 2)
+	require.NoError(t, err)
+	for _, withSolutions := range []bool{true, false} {
+		b, err := srv.PlotSlopeField(0, 1, 2, sols, withSolutions)
+		require.NoError(t, err)
+		assert.NotEmpty(t, b)
+	}
+
+	// the region covers the finite points of the solutions
+	lines := []num.Line{{Points: []num.Point{{X: 1, Y: 3}, {X: 2, Y: math.Inf(1)}, {X: 3, Y: -1}}}}
+	assert.Equal(t, graph.Region{XMin: 0, XMax: 4, YMin: -1, YMax: 3}, regionOf(lines, 4, 0.5, 0))
+
+	sys, err := NewSystem(func(x float64, y num.Vector) (num.Vector, error) { return y, nil },
+		[]string{solver.MethodRK4}, graph.Plotter{})
+	require.NoError(t, err)
+	_, err = sys.PlotSlopeField(0, 1, 2, nil, true)
+	assert.EqualError(t, err, "slope field requires the equation y' = f(x,y)")
+}
+
+func TestService_PhasePortrait(t *testing.T) {
+	// Lotka-Volterra equations with the equilibria at (0, 0) and (1, 1),
+	// the trajectories are the closed orbits around (1, 1)
+	lv := func(x float64, y num.Vector) (num.Vector, error) {
+		return num.Vector{y[0] - y[0]*y[1], y[0]*y[1] - y[1]}, nil
+	}
+	srv, err := NewSystem(lv, []string{solver.MethodRK4, solver.MethodEuler}, graph.Plotter{Width: 2, DPI: 50})
+	require.NoError(t, err)
+
+	// the last trajectory lies on the axis y2 = 0, so the region covers the origin
+	y0s := []num.Vector{{0.5, 0.5}, {1.5, 1}, {0.05, 0}}
+	ph, err := srv.PhasePortrait(0.01, 0, y0s, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "Runge-Kutta's method", ph.Method)
+	require.Len(t, ph.Trajectories, 3)
+	assert.Equal(t, "y0 = (0.5, 0.5)", ph.Trajectories[0].Name)
+	for i, traj := range ph.Trajectories[:2] {
+		require.Len(t, traj.Points, 301)
+		assert.Equal(t, num.Point{X: y0s[i][0], Y: y0s[i][1]}, traj.Points[0])
+		// the first integral x - ln x + y - ln y is conserved along the orbits
+		first := func(p num.Point) float64 { return p.X - math.Log(p.X) + p.Y - math.Log(p.Y) }
+		for _, pt := range traj.Points {
+			assert.InDelta(t, first(traj.Points[0]), first(pt), 1e-6)
+		}
+	}
+
+	require.Len(t, ph.Equilibria, 2)
+	for _, want := range []num.Point{{X: 0, Y: 0}, {X: 1, Y: 1}} {
+		found := false
+		for _, eq := range ph.Equilibria {
+			found = found || math.Hypot(eq.X-want.X, eq.Y-want.Y) < 1e-9
+		}
+		assert.True(t, found, "equilibrium %s is not found in %v", want, ph.Equilibria)
+	}
+
+	b, err := srv.PlotPhasePortrait(0.01, 0, y0s, 3)
+	require.NoError(t, err)
+	assert.NotEmpty(t, b)
+
+	// the trajectory is cut at the blow-up
+	blowUp, err := NewSystem(func(x float64, y num.Vector) (num.Vector, error) {
+		return num.Vector{y[0] * y[0], -y[1]}, nil
+	}, []string{solver.MethodEuler}, graph.Plotter{})
+	require.NoError(t, err)
+	ph, err = blowUp.PhasePortrait(0.5, 0, []num.Vector{{1, 1}}, 100)
+	require.NoError(t, err)
+	assert.Less(t, len(ph.Trajectories[0].Points), 201)
+
+	tbl := []struct {
+		sys func(x float64, y num.Vector) (num.Vector, error)
+		y0s []num.Vector
+		err string
+	}{
+		{lv, nil, "phase portrait requires at least one initial point"},
+		{lv, []num.Vector{{1, 1}, {1, 2, 3}}, "phase portrait requires the system of two equations, " +
+			"initial point 2 has 3 components"},
+		{func(x float64, y num.Vector) (num.Vector, error) { return num.Vector{y[1], -y[0] + math.Sin(x)}, nil },
+			[]num.Vector{{1, 1}}, "phase portrait requires the autonomous system, F2 depends on x at y=[1 1]"},
+	}
+	for i, tt := range tbl {
+		srv, err := NewSystem(tt.sys, []string{solver.MethodRK4}, graph.Plotter{})
+		require.NoError(t, err, "case %d", i)
+		_, err = srv.PhasePortrait(0.1, 0, tt.y0s, 1)
+		assert.EqualError(t, err, tt.err, "case %d", i)
+	}
+
+	p, err := ParseProblem("-y", "", "")
+	require.NoError(t, err)
+	scalar, err := New(p, []string{solver.MethodRK4}, graph.Plotter{})
+	require.NoError(t, err)
+	_, err = scalar.PhasePortrait(0.1, 0, []num.Vector{{1, 1}}, 1)
+	assert.EqualError(t, err, "phase portrait requires the system of equations")
+}
+
+func TestParseSweep(t *testing.T) {
+	tbl := []struct {
+		s    string
+		want Sweep
+		err  string
+	}{
+		{"", Sweep{}, ""},
+		{"-1, 0,1.5", Sweep{Values: []float64{-1, 0, 1.5}}, ""},
+		{" -1 : 1 : 5 ", Sweep{From: -1, To: 1, N: 5}, ""},
+		{"1, a", Sweep{}, "can't read value 2"},
+		{"1:2", Sweep{}, `range "1:2" must be in the form from:to:n`},
+		{"1:2:1.5", Sweep{}, `can't read number of values of range "1:2:1.5"`},
+	}
+	for i, tt := range tbl {
+		sw, err := ParseSweep(tt.s)
+		if tt.err != "" {
+			require.Error(t, err, "case %d", i)
+			assert.Contains(t, err.Error(), tt.err, "case %d", i)
+			continue
+		}
+		require.NoError(t, err, "case %d", i)
+		assert.Equal(t, tt.want, sw, "case %d", i)
+	}
+}
+
+func TestSweep_Points(t *testing.T) {
+	tbl := []struct {
+		sw   Sweep
+		want []float64
+		err  string
+	}{
+		{Sweep{}, []float64{7}, ""},
+		{Sweep{Values: []float64{3, 1}}, []float64{3, 1}, ""},
+		{Sweep{From: 1, To: -1, N: 5}, []float64{1, 0.5, 0, -0.5, -1}, ""},
+		{Sweep{Values: []float64{1}, N: 2}, nil, "sweep must have either the values or the range"},
+		{Sweep{Values: []float64{math.NaN()}}, nil, "value NaN of sweep must be finite"},
+		{Sweep{From: 0, To: 1, N: 1}, nil, "number of values n=1 of range must be from 2 to 100"},
+		{Sweep{From: 0, To: 1, N: 101}, nil, "number of values n=101 of range must be from 2 to 100"},
+		{Sweep{From: 1, To: 1, N: 3}, nil, "range from 1 to 1 must be finite and not empty"},
+	}
+	for i, tt := range tbl {
+		pts, err := tt.sw.Points(7)
+		if tt.err != "" {
+			assert.EqualError(t, err, tt.err, "case %d", i)
+			continue
+		}
+		require.NoError(t, err, "case %d", i)
+		assert.Equal(t, tt.want, pts, "case %d", i)
+	}
+}
+
+func TestService_SolutionFamily(t *testing.T) {
+	p, err := ParseProblem("-y", "", "")
+	require.NoError(t, err)
+	srv, err := New(p, []string{solver.MethodRK4, solver.MethodEuler}, graph.Plotter{Width: 2, DPI: 50})
+	require.NoError(t, err)
+
+	// y = y0 * exp(x0 - x)
+	fam, err := srv.SolutionFamily(20, []float64{0}, []float64{-1, 0, 2}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "Runge-Kutta's method", fam.Method)
+	require.Len(t, fam.Lines, 3)
+	for i, y0 := range []float64{-1, 0, 2} {
+		assert.Equal(t, fmt.Sprintf("y0 = %g", y0), fam.Lines[i].Name)
+		require.Len(t, fam.Lines[i].Points, 21)
+		for _, pt := range fam.Lines[i].Points {
+			assert.InDelta(t, y0*math.Exp(-pt.X), pt.Y, 1e-5)
+		}
+	}
+
+	// each of x0 has its own grid of n steps
+	fam, err = srv.SolutionFamily(10, []float64{0, 1}, []float64{1, 2}, 2)
+	require.NoError(t, err)
+	require.Len(t, fam.Lines, 4)
+	assert.Equal(t, "x0 = 1, y0 = 2", fam.Lines[3].Name)
+	assert.Equal(t, num.Point{X: 1, Y: 2}, fam.Lines[3].Points[0])
+	assert.InDelta(t, 0.1, fam.Lines[3].Points[1].X-fam.Lines[3].Points[0].X, 1e-12)
+
+	fam, err = srv.SolutionFamily(10, []float64{0, 1}, []float64{1}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "x0 = 0", fam.Lines[0].Name)
+
+	b, err := srv.PlotSolutionFamily(10, []float64{0}, []float64{-1, 0, 1, 2}, 2)
+	require.NoError(t, err)
+	assert.NotEmpty(t, b)
+
+	// variant 8, the solutions with y0 above the separatrix blow up, they are cut at the blow-up
+	// or stopped at the terminal event, the ones below it decay
+	p, err = ParseProblem("y*y*exp(x) - 2*y", "", "")
+	require.NoError(t, err)
+	v8, err := New(p, []string{solver.MethodRK4}, graph.Plotter{})
+	require.NoError(t, err)
+	v8.Events, err = ParseEvents([]EventSpec{{G: "y - 100", Terminal: true}})
+	require.NoError(t, err)
+	fam, err = v8.SolutionFamily(100, []float64{0}, []float64{0.5, 3}, 2)
+	require.NoError(t, err)
+	assert.Len(t, fam.Lines[0].Points, 101)
+	last := fam.Lines[1].Points[len(fam.Lines[1].Points)-1]
+	assert.Less(t, last.X, 2.0)
+	assert.InDelta(t, 100, last.Y, 1e-6)
+
+	tbl := []struct {
+		n        int
+		x0s, y0s []float64
+		err      string
+	}{
+		{0, []float64{0}, []float64{1}, "n=0 must be positive"},
+		{10, nil, []float64{1}, "family of solutions requires at least one initial point"},
+		{10, make([]float64, 11), make([]float64, 10), "family of 110 solutions exceeds 100 solutions"},
+		{10, []float64{0, 2}, []float64{1}, "x_end=2 must differ from x0=2"},
+	}
+	for i, tt := range tbl {
+		_, err = srv.SolutionFamily(tt.n, tt.x0s, tt.y0s, 2)
+		assert.EqualError(t, err, tt.err, "case %d", i)
+	}
+
+	sys, err := NewSystem(func(x float64, y num.Vector) (num.Vector, error) { return y, nil },
+		[]string{solver.MethodRK4}, graph.Plotter{})
+	require.NoError(t, err)
+	_, err = sys.SolutionFamily(10, []float64{0}, []float64{1}, 1)
+	assert.EqualError(t, err, "family of solutions requires the equation y' = f(x,y)")
+}
+
+func TestValidateGrid(t *testing.T) {
+	assert.NoError(t, ValidateGrid(0, 1, 10))
+	assert.NoError(t, ValidateGrid(1, 0, 10), "backwards")
+	assert.Error(t, ValidateGrid(1, 1, 10))
+	assert.Error(t, ValidateGrid(0, 1, 0))
+	assert.Error(t, ValidateGrid(0, 1, -1))
+	assert.NoError(t, ValidateGrid(0, 1, maxSteps))
+	assert.Error(t, ValidateGrid(0, 1, maxSteps+1))
+
+	assert.NoError(t, ValidateGrids(0, 1, 10, 10))
+	assert.Error(t, ValidateGrids(1, 1, 10, 20))
+	assert.Error(t, ValidateGrids(0, 1, 0, 20))
+	assert.Error(t, ValidateGrids(0, 1, 20, 10))
+	assert.NoError(t, ValidateGrids(0, 1, maxGTESteps-maxGrids+1, maxGTESteps))
+	assert.Error(t, ValidateGrids(0, 1, maxGTESteps-maxGrids, maxGTESteps), "too many grids")
+	assert.Error(t, ValidateGrids(0, 1, maxGTESteps, maxGTESteps+1), "too many steps")
+}
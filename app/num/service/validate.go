@@ -0,0 +1,51 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+)
+
+// limits of the grids, as the time of solving grows linearly with the number of steps,
+// and GlobalErrors solves the problem on each grid from nmin to nmax, i.e. O(nmax^2) steps
+const (
+	maxSteps    = 100000 // max number of steps of a single grid
+	maxGTESteps = 10000  // max number of steps nmax of the grids of global errors
+	maxGrids    = 1000   // max number of grids of global errors, nmax-nmin+1
+)
+
+// ValidateGrid checks the interval from x0 to xEnd and the number of steps n of the grid on it
+func ValidateGrid(x0, xEnd float64, n int) error {
+	if err := validateInterval(x0, xEnd); err != nil {
+		return err
+	}
+	switch {
+	case n <= 0:
+		return errors.Errorf("n=%d must be positive", n)
+	case n > maxSteps:
+		return errors.Errorf("n=%d must not exceed %d", n, maxSteps)
+	}
+	return nil
+}
+
+// ValidateGrids checks the interval from x0 to xEnd and the range of the numbers of steps
+// from nmin to nmax of the grids on it, e.g. for GlobalErrors
+func ValidateGrids(x0, xEnd float64, nmin, nmax int) error {
+	if err := validateInterval(x0, xEnd); err != nil {
+		return err
+	}
+	switch {
+	case nmin <= 0 || nmax < nmin:
+		return errors.Errorf("nmin=%d must be positive and not greater than nmax=%d", nmin, nmax)
+	case nmax > maxGTESteps:
+		return errors.Errorf("nmax=%d must not exceed %d", nmax, maxGTESteps)
+	case nmax-nmin+1 > maxGrids:
+		return errors.Errorf("%d grids from nmin=%d to nmax=%d exceed %d grids", nmax-nmin+1, nmin, nmax, maxGrids)
+	}
+	return nil
+}
+
+func validateInterval(x0, xEnd float64) error {
+	if xEnd == x0 {
+		return errors.Errorf("x_end=%g must differ from x0=%g", xEnd, x0)
+	}
+	return nil
+}
@@ -0,0 +1,288 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/Semior001/decompract/app/num"
+	log "github.com/go-pkgz/lgr"
+	"github.com/pkg/errors"
+)
+
+// defaultAdamsOrder is used when the order of Adams method is not set
+const defaultAdamsOrder = 4
+
+// coefficients of Adams-Bashforth methods by order, the j-th coefficient is
+// the weight of f_{i-j}
+var abCoeffs = map[int][]float64{
+	2: {3.0 / 2.0, -1.0 / 2.0},
+	3: {23.0 / 12.0, -16.0 / 12.0, 5.0 / 12.0},
+	4: {55.0 / 24.0, -59.0 / 24.0, 37.0 / 24.0, -9.0 / 24.0},
+	5: {1901.0 / 720.0, -2774.0 / 720.0, 2616.0 / 720.0, -1274.0 / 720.0, 251.0 / 720.0},
+}
+
+// coefficients of Adams-Moulton methods by order, the j-th coefficient is
+// the weight of f_{i+1-j}
+var amCoeffs = map[int][]float64{
+	2: {1.0 / 2.0, 1.0 / 2.0},
+	3: {5.0 / 12.0, 8.0 / 12.0, -1.0 / 12.0},
+	4: {9.0 / 24.0, 19.0 / 24.0, -5.0 / 24.0, 1.0 / 24.0},
+	5: {251.0 / 720.0, 646.0 / 720.0, -264.0 / 720.0, 106.0 / 720.0, -19.0 / 720.0},
+}
+
+// adamsOrder returns the order of Adams method, defaultAdamsOrder if not set
+func adamsOrder(order int) int {
+	if order == 0 {
+		return defaultAdamsOrder
+	}
+	return order
+}
+
+// AdamsBashforth is an explicit linear multistep method for solving initial
+// value problem for differential equations, the first steps are made with
+// the classic Runge-Kutta method
+type AdamsBashforth struct {
+	F     func(x, y float64) (float64, error) // calculator for f(x,y) = y'
+	Order int                                 // order of the method from 2 to 5, 4 if not set
+}
+
+// Solve the initial value problem with Adams-Bashforth method, which is
+// y_{i+1} = y_i + h * \sum_j b_j f_{i-j}
+func (a *AdamsBashforth) Solve(stepSize, x0, y0, xEnd float64) (num.Line, error) {
+	b, err := a.coeffs()
+	if err != nil {
+		return num.Line{}, err
+	}
+	return multistep{name: a.Name(), f: a.F, steps: len(b), step: a.next}.solve(stepSize, x0, y0, xEnd)
+}
+
+// Name returns the name of the method with its order
+func (a *AdamsBashforth) Name() string {
+	return fmt.Sprintf("Adams-Bashforth's method (order %d)", adamsOrder(a.Order))
+}
+
+// Steps returns the number of previous points, required by the method
+func (a *AdamsBashforth) Steps() int {
+	b, err := a.coeffs()
+	if err != nil {
+		return 1
+	}
+	return len(b)
+}
+
+// Step makes a single step of the size h from the given points
+func (a *AdamsBashforth) Step(h float64, prev []num.Point) (float64, error) {
+	b, err := a.coeffs()
+	if err != nil {
+		return 0, err
+	}
+	return multistep{f: a.F, steps: len(b), step: a.next}.stepFrom(h, prev)
+}
+
+func (a *AdamsBashforth) coeffs() ([]float64, error) {
+	b, ok := abCoeffs[adamsOrder(a.Order)]
+	if !ok {
+		return nil, errors.Errorf("order %d of Adams-Bashforth method is not supported", a.Order)
+	}
+	return b, nil
+}
+
+// next calculates y_{i+1}, the order is checked by the caller
+func (a *AdamsBashforth) next(h, _, yi float64, fs []float64) (float64, error) {
+	return yi + h*combine(abCoeffs[adamsOrder(a.Order)], fs), nil
+}
+
+// AdamsMoulton is an implicit linear multistep method for solving initial
+// value problem for differential equations, the implicit equation is solved
+// with Newton iteration, the first steps are made with the classic
+// Runge-Kutta method
+type AdamsMoulton struct {
+	F     func(x, y float64) (float64, error) // calculator for f(x,y) = y'
+	DFDY  func(x, y float64) (float64, error) // calculator for ∂f/∂y, approximated numerically if not set
+	Order int                                 // order of the method from 2 to 5, 4 if not set
+}
+
+// Solve the initial value problem with Adams-Moulton method, which is
+// y_{i+1} = y_i + h * (b_0 f_{i+1} + \sum_{j>0} b_j f_{i+1-j})
+func (a *AdamsMoulton) Solve(stepSize, x0, y0, xEnd float64) (num.Line, error) {
+	b, err := a.coeffs()
+	if err != nil {
+		return num.Line{}, err
+	}
+	return multistep{name: a.Name(), f: a.F, steps: len(b) - 1, step: a.next}.solve(stepSize, x0, y0, xEnd)
+}
+
+// Name returns the name of the method with its order
+func (a *AdamsMoulton) Name() string {
+	return fmt.Sprintf("Adams-Moulton's method (order %d)", adamsOrder(a.Order))
+}
+
+// Steps returns the number of previous points, required by the method
+func (a *AdamsMoulton) Steps() int {
+	b, err := a.coeffs()
+	if err != nil {
+		return 1
+	}
+	return len(b) - 1
+}
+
+// Step makes a single step of the size h from the given points
+func (a *AdamsMoulton) Step(h float64, prev []num.Point) (float64, error) {
+	b, err := a.coeffs()
+	if err != nil {
+		return 0, err
+	}
+	return multistep{f: a.F, steps: len(b) - 1, step: a.next}.stepFrom(h, prev)
+}
+
+func (a *AdamsMoulton) coeffs() ([]float64, error) {
+	b, ok := amCoeffs[adamsOrder(a.Order)]
+	if !ok {
+		return nil, errors.Errorf("order %d of Adams-Moulton method is not supported", a.Order)
+	}
+	return b, nil
+}
+
+// next solves the implicit equation for y_{i+1}, the order is checked by the caller
+func (a *AdamsMoulton) next(h, xi, yi float64, fs []float64) (float64, error) {
+	b := amCoeffs[adamsOrder(a.Order)]
+	// the explicit Euler step is used as an initial guess
+	return newton{f: a.F, dfdy: a.DFDY}.solve(xi+h, yi+h*combine(b[1:], fs), h*b[0], yi+h*fs[0])
+}
+
+// AdamsBashforthMoulton is a predictor-corrector method (PECE), which predicts
+// the solution with Adams-Bashforth method and corrects it once with
+// Adams-Moulton method of the same order
+type AdamsBashforthMoulton struct {
+	F     func(x, y float64) (float64, error) // calculator for f(x,y) = y'
+	Order int                                 // order of the method from 2 to 5, 4 if not set
+}
+
+// Solve the initial value problem with Adams-Bashforth-Moulton method
+func (a *AdamsBashforthMoulton) Solve(stepSize, x0, y0, xEnd float64) (num.Line, error) {
+	pb, err := a.coeffs()
+	if err != nil {
+		return num.Line{}, err
+	}
+	return multistep{name: a.Name(), f: a.F, steps: len(pb), step: a.next}.solve(stepSize, x0, y0, xEnd)
+}
+
+// Name returns the name of the method with its order
+func (a *AdamsBashforthMoulton) Name() string {
+	return fmt.Sprintf("Adams-Bashforth-Moulton's method (order %d)", adamsOrder(a.Order))
+}
+
+// Steps returns the number of previous points, required by the method
+func (a *AdamsBashforthMoulton) Steps() int {
+	pb, err := a.coeffs()
+	if err != nil {
+		return 1
+	}
+	return len(pb)
+}
+
+// Step makes a single step of the size h from the given points
+func (a *AdamsBashforthMoulton) Step(h float64, prev []num.Point) (float64, error) {
+	pb, err := a.coeffs()
+	if err != nil {
+		return 0, err
+	}
+	return multistep{f: a.F, steps: len(pb), step: a.next}.stepFrom(h, prev)
+}
+
+func (a *AdamsBashforthMoulton) coeffs() ([]float64, error) {
+	pb, ok := abCoeffs[adamsOrder(a.Order)]
+	if !ok {
+		return nil, errors.Errorf("order %d of Adams-Bashforth-Moulton method is not supported", a.Order)
+	}
+	return pb, nil
+}
+
+// next predicts y_{i+1} and corrects it once, the order is checked by the caller
+func (a *AdamsBashforthMoulton) next(h, xi, yi float64, fs []float64) (float64, error) {
+	order := adamsOrder(a.Order)
+	pb, cb := abCoeffs[order], amCoeffs[order]
+	// predict
+	yp := yi + h*combine(pb, fs)
+	// evaluate
+	fp, err := a.F(xi+h, yp)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to calculate f for predicted x=%.4f y=%.4f", xi+h, yp)
+	}
+	// correct, the last evaluation is made on the next step
+	return yi + h*(cb[0]*fp+combine(cb[1:], fs)), nil
+}
+
+// multistep implements the common loop for the linear multistep methods
+type multistep struct {
+	name  string
+	f     func(x, y float64) (float64, error)
+	steps int // number of previous values of f, required for the step
+	// step calculates y_{i+1} by y_i and the previous values of f, where fs[j] = f_{i-j}
+	step func(h, xi, yi float64, fs []float64) (float64, error)
+}
+
+// solve the initial value problem, the first steps-1 values are calculated
+// with the classic Runge-Kutta method
+func (m multistep) solve(stepSize, x0, y0, xEnd float64) (num.Line, error) {
+	y := y0
+	var err error
+
+	log.Printf("[DEBUG] starting solving the equation with %s "+
+		"with stepsz = %.4f, x0 = %.4f, y0 = %.4f, xend = %.4f", m.name, stepSize, x0, y0, xEnd)
+
+	rk := &Explicit{F: m.f, Tableau: builtinTableaux[TableauRK4]}
+	k := make([]float64, rk.Tableau.Stages())
+
+	xs := num.Grid(stepSize, x0, xEnd)
+	pts := make([]num.Point, 0, len(xs))
+	fs := make([]float64, m.steps) // previous values of f, fs[0] is the latest one
+	for i, x := range xs {
+		if i > 0 {
+			prev := xs[i-1]
+			copy(fs[1:], fs[:len(fs)-1])
+			if fs[0], err = m.f(prev, y); err != nil {
+				return num.Line{}, errors.Wrapf(err, "failed to calculate f for x=%.4f y=%.4f", prev, y)
+			}
+
+			if i < m.steps {
+				y, err = rk.step(k, stepSize, prev, y)
+			} else {
+				y, err = m.step(stepSize, prev, y, fs)
+			}
+			if err != nil {
+				return num.Line{}, errors.Wrapf(err, "failed to make a step at x=%.4f", prev)
+			}
+		}
+		pts = append(pts, num.Point{X: x, Y: y})
+	}
+
+	return num.Line{Name: m.name, Points: pts}, nil
+}
+
+// stepFrom makes a single step from the given points, where the last one is
+// (x_i, y_i), the classic Runge-Kutta method is used, if there are not enough points
+func (m multistep) stepFrom(h float64, prev []num.Point) (float64, error) {
+	if len(prev) < m.steps {
+		return (&Explicit{F: m.f, Tableau: builtinTableaux[TableauRK4]}).Step(h, prev)
+	}
+
+	fs := make([]float64, m.steps)
+	for j := range fs {
+		p := prev[len(prev)-1-j]
+		var err error
+		if fs[j], err = m.f(p.X, p.Y); err != nil {
+			return 0, errors.Wrapf(err, "failed to calculate f for x=%.4f y=%.4f", p.X, p.Y)
+		}
+	}
+	last := prev[len(prev)-1]
+	return m.step(h, last.X, last.Y, fs)
+}
+
+// combine calculates \sum_j b_j f_j
+func combine(b, fs []float64) float64 {
+	res := 0.0
+	for j := range b {
+		res += b[j] * fs[j]
+	}
+	return res
+}
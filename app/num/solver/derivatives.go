@@ -0,0 +1,35 @@
+package solver
+
+// Derivatives are the analytic derivatives of f(x,y) of the equation y' = f(x,y),
+// the methods, that use them, approximate them numerically if they are not set
+type Derivatives struct {
+	DFDY  func(x, y float64) (float64, error)   // ∂f/∂y, used by Newton iteration of implicit methods
+	Total []func(x, y float64) (float64, error) // y'', y''', ... along the solution, used by Taylor's methods
+}
+
+// Apply provides the derivatives to the solver, if it uses them and
+// its own derivatives are not set
+func (d Derivatives) Apply(s Interface) {
+	switch s := s.(type) {
+	case *BackwardEuler:
+		if s.DFDY == nil {
+			s.DFDY = d.DFDY
+		}
+	case *Trapezoidal:
+		if s.DFDY == nil {
+			s.DFDY = d.DFDY
+		}
+	case *BDF2:
+		if s.DFDY == nil {
+			s.DFDY = d.DFDY
+		}
+	case *AdamsMoulton:
+		if s.DFDY == nil {
+			s.DFDY = d.DFDY
+		}
+	case *Taylor:
+		if s.Derivs == nil {
+			s.Derivs = d.Total
+		}
+	}
+}
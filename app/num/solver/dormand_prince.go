@@ -0,0 +1,175 @@
+package solver
+
+import (
+	"math"
+
+	"github.com/Semior001/decompract/app/num"
+	log "github.com/go-pkgz/lgr"
+	"github.com/pkg/errors"
+)
+
+// default tolerances and limits for the adaptive step size control
+const (
+	defaultAbsTol   = 1e-6
+	defaultRelTol   = 1e-6
+	defaultMaxSteps = 100000
+)
+
+// Dormand-Prince 5(4) coefficients, the last stage is evaluated at the
+// accepted point, so it is reused as the first stage of the next step (FSAL)
+var (
+	dpC = [7]float64{0, 1.0 / 5.0, 3.0 / 10.0, 4.0 / 5.0, 8.0 / 9.0, 1, 1}
+	dpA = [7][6]float64{
+		{},
+		{1.0 / 5.0},
+		{3.0 / 40.0, 9.0 / 40.0},
+		{44.0 / 45.0, -56.0 / 15.0, 32.0 / 9.0},
+		{19372.0 / 6561.0, -25360.0 / 2187.0, 64448.0 / 6561.0, -212.0 / 729.0},
+		{9017.0 / 3168.0, -355.0 / 33.0, 46732.0 / 5247.0, 49.0 / 176.0, -5103.0 / 18656.0},
+		{35.0 / 384.0, 0, 500.0 / 1113.0, 125.0 / 192.0, -2187.0 / 6784.0, 11.0 / 84.0},
+	}
+	// difference between the 5th and 4th order weights, used to estimate the error
+	dpE = [7]float64{
+		71.0 / 57600.0, 0, -71.0 / 16695.0, 71.0 / 1920.0, -17253.0 / 339200.0, 22.0 / 525.0, -1.0 / 40.0,
+	}
+)
+
+// DormandPrince is an adaptive Runge-Kutta method of order 5 with embedded
+// 4th order error estimation, it chooses the step size on its own
+// in order to keep the local error within the given tolerances
+type DormandPrince struct {
+	F        func(x, y float64) (float64, error) // calculator for f(x,y) = y'
+	AbsTol   float64                             // absolute tolerance, 1e-6 if not set
+	RelTol   float64                             // relative tolerance, 1e-6 if not set
+	MaxSteps int                                 // max number of attempted steps, 100000 if not set
+}
+
+// Solve the initial value problem with Dormand-Prince method, stepSize is used
+// only as an initial guess of the step size, the resulting line contains
+// only accepted points, which are not uniformly distributed, the equation
+// is integrated backwards, if xEnd < x0
+func (d *DormandPrince) Solve(stepSize, x0, y0, xEnd float64) (num.Line, error) {
+	atol, rtol, maxSteps := d.AbsTol, d.RelTol, d.MaxSteps
+	if atol <= 0 {
+		atol = defaultAbsTol
+	}
+	if rtol <= 0 {
+		rtol = defaultRelTol
+	}
+	if maxSteps <= 0 {
+		maxSteps = defaultMaxSteps
+	}
+
+	log.Printf("[DEBUG] starting solving the equation with Dormand-Prince's method with initial stepsz = %.4f, "+
+		"x0 = %.4f, y0 = %.4f, xend = %.4f, atol = %g, rtol = %g", stepSize, x0, y0, xEnd, atol, rtol)
+
+	// h is the absolute value of the step size, dir is the direction of integration
+	dir := 1.0
+	if xEnd < x0 {
+		dir = -1
+	}
+	h := math.Min(math.Abs(stepSize), math.Abs(xEnd-x0))
+	if h <= 0 {
+		h = math.Abs(xEnd-x0) / 100
+	}
+
+	x, y := x0, y0
+	pts := []num.Point{{X: x, Y: y}}
+	if xEnd == x0 {
+		return num.Line{Name: d.Name(), Points: pts}, nil
+	}
+
+	var k [7]float64
+	var err error
+	if k[0], err = d.F(x, y); err != nil {
+		return num.Line{}, errors.Wrapf(err, "failed to calculate k1 for x=%.4f y=%.4f", x, y)
+	}
+
+	for step := 0; dir*(xEnd-x) > 0; step++ {
+		if step >= maxSteps {
+			return num.Line{}, errors.Errorf("max number of steps %d exceeded at x=%.4f", maxSteps, x)
+		}
+
+		// do not step over the end of the interval
+		last := false
+		if dir*(x+dir*h-xEnd) >= 0 {
+			h = math.Abs(xEnd - x)
+			last = true
+		}
+		if h <= math.Abs(x)*1e-14 {
+			return num.Line{}, errors.Errorf("step size underflow at x=%.4f, h=%g", x, h)
+		}
+
+		hs := dir * h // signed step
+		for i := 1; i < 7; i++ {
+			yi := y
+			for j := 0; j < i; j++ {
+				yi += hs * dpA[i][j] * k[j]
+			}
+			if k[i], err = d.F(x+dpC[i]*hs, yi); err != nil {
+				return num.Line{}, errors.Wrapf(err, "failed to calculate k%d for h=%.4f, x=%.4f, y=%.4f", i+1, hs, x, y)
+			}
+		}
+
+		// the 7th stage is evaluated exactly at the 5th order solution
+		yNext := y
+		for j := 0; j < 6; j++ {
+			yNext += hs * dpA[6][j] * k[j]
+		}
+
+		var estimate float64
+		for j := range dpE {
+			estimate += hs * dpE[j] * k[j]
+		}
+
+		scale := atol + rtol*math.Max(math.Abs(y), math.Abs(yNext))
+		e := math.Abs(estimate) / scale
+		if math.IsNaN(e) || math.IsInf(e, 0) {
+			return num.Line{}, errors.Errorf("error estimate is not finite at x=%.4f, h=%g", x, h)
+		}
+
+		if e <= 1 {
+			if last {
+				x = xEnd
+			} else {
+				x += hs
+			}
+			y = yNext
+			k[0] = k[6]
+			pts = append(pts, num.Point{X: x, Y: y})
+		}
+
+		h *= stepFactor(e)
+	}
+
+	return num.Line{Name: d.Name(), Points: pts}, nil
+}
+
+// Name returns "Dormand-Prince's method"
+func (d *DormandPrince) Name() string { return "Dormand-Prince's method" }
+
+// Adaptive returns true, as the step sizes are chosen by the local error estimate
+func (d *DormandPrince) Adaptive() bool { return true }
+
+// Steps returns the number of previous points, required by the method
+func (d *DormandPrince) Steps() int { return 1 }
+
+// Step makes a single step of the 5th order method with the fixed step size h,
+// without the error control
+func (d *DormandPrince) Step(h float64, prev []num.Point) (float64, error) {
+	t := Tableau{Name: d.Name(), A: make([][]float64, 6), B: dpA[6][:6], C: dpC[:6]}
+	for i := range t.A {
+		t.A[i] = dpA[i][:i]
+	}
+	return (&Explicit{F: d.F, Tableau: t}).Step(h, prev)
+}
+
+// stepFactor calculates the multiplier of the step size from the normalized
+// error estimate of the 5th order method
+func stepFactor(e float64) float64 {
+	const safety, minFactor, maxFactor = 0.9, 0.2, 5.0
+	if e == 0 {
+		return maxFactor
+	}
+	return math.Min(maxFactor, math.Max(minFactor, safety*math.Pow(e, -1.0/5.0)))
+}
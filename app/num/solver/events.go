@@ -0,0 +1,193 @@
+package solver
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/Semior001/decompract/app/num"
+)
+
+// eventTol is the relative tolerance of the located roots of the event functions
+const eventTol = 1e-12
+
+// Event describes the function g(x,y), the roots of which are located on the solution,
+// e.g. g = y - 0.5 for the moment, when the solution crosses 0.5
+type Event struct {
+	Name      string                              // name of the event in the results
+	G         func(x, y float64) (float64, error) // event function, the event occurs, when it changes its sign
+	Direction int                                 // 1 - only the roots, where g grows along the integration, -1 - falls, 0 - both
+	Terminal  bool                                // stop the integration at the first root
+}
+
+// EventPoint describes the located root of the event function
+type EventPoint struct {
+	Event    string  `json:"event"`
+	X        float64 `json:"x"`
+	Y        float64 `json:"y"`
+	Terminal bool    `json:"terminal"`
+}
+
+// SolveEvents solves the initial value problem with the solver on the grid with the given step
+// size and locates the roots of the event functions between the steps, the integration is stopped
+// at the first root of the terminal event, which becomes the last point of the solution.
+// Methods, that make single steps, step on the grid, the other ones, e.g. the adaptive
+// Dormand-Prince method, solve the problem from one grid point to the next.
+// The solution between the steps is approximated by the cubic Hermite interpolation by the values
+// of y and f = y' at the ends of the step, if they are not finite at the right end, e.g. the step
+// went over the singularity, it is approximated by the step of the classic Runge-Kutta method
+// from the left end, so f is not evaluated beyond the root
+func SolveEvents(s Interface, f func(x, y float64) (float64, error), events []Event,
+	stepSize, x0, y0, xEnd float64) (num.Line, []EventPoint, error) {
+	gs := make([]float64, len(events)) // values of the event functions at the last point
+	for i, ev := range events {
+		var err error
+		if gs[i], err = ev.G(x0, y0); err != nil {
+			return num.Line{}, nil, errors.Wrapf(err, "failed to calculate event %s for x=%.4f y=%.4f", ev.Name, x0, y0)
+		}
+	}
+
+	advance := advancer(s)
+	xs := num.Grid(stepSize, x0, xEnd)
+	pts := make([]num.Point, 1, len(xs))
+	pts[0] = num.Point{X: x0, Y: y0}
+	var found []EventPoint
+	var name string // name of the method is taken from its solution
+	for i := 1; i < len(xs); i++ {
+		var y float64
+		var err error
+		if y, name, err = advance(pts, xs[i]); err != nil {
+			return num.Line{}, nil, errors.Wrapf(err, "failed to make a step at x=%.4f", xs[i-1])
+		}
+		next := num.Point{X: xs[i], Y: y}
+
+		roots, gNext, err := locateEvents(f, events, gs, pts[len(pts)-1], next)
+		if err != nil {
+			return num.Line{}, nil, err
+		}
+		for _, r := range roots {
+			found = append(found, r)
+			if r.Terminal {
+				pts = append(pts, num.Point{X: r.X, Y: r.Y})
+				return num.Line{Name: name, Points: pts}, found, nil
+			}
+		}
+
+		pts = append(pts, next)
+		gs = gNext
+	}
+
+	return num.Line{Name: name, Points: pts}, found, nil
+}
+
+// advancer returns the function, that calculates the solution at x by the previous points,
+// it also returns the name of the method, which made the step
+func advancer(s Interface) func(prev []num.Point, x float64) (float64, string, error) {
+	// adaptive method chooses the steps on its own, so it is not stepped on the grid
+	if st, ok := s.(Stepper); ok {
+		if !IsAdaptive(s) {
+			return func(prev []num.Point, x float64) (float64, string, error) {
+				from := len(prev) - st.Steps()
+				if from < 0 {
+					from = 0
+				}
+				last := prev[len(prev)-1]
+				y, err := st.Step(x-last.X, prev[from:])
+				return y, st.Name(), err
+			}
+		}
+	}
+
+	return func(prev []num.Point, x float64) (float64, string, error) {
+		last := prev[len(prev)-1]
+		line, err := s.Solve(x-last.X, last.X, last.Y, x)
+		if err != nil {
+			return 0, "", err
+		}
+		return line.Points[len(line.Points)-1].Y, line.Name, nil
+	}
+}
+
+// locateEvents locates the roots of the event functions on the step from a to b, gs are the values of
+// the event functions at a, returns the roots in the order of integration and the values at b
+func locateEvents(f func(x, y float64) (float64, error), events []Event, gs []float64,
+	a, b num.Point) ([]EventPoint, []float64, error) {
+	gNext := make([]float64, len(events))
+	var dense func(x float64) (float64, error)
+	var roots []EventPoint
+	for i, ev := range events {
+		var err error
+		if gNext[i], err = ev.G(b.X, b.Y); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to calculate event %s for x=%.4f y=%.4f", ev.Name, b.X, b.Y)
+		}
+		if !crossed(gs[i], gNext[i], ev.Direction) {
+			continue
+		}
+
+		if dense == nil {
+			if dense, err = denseOutput(f, a, b); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		x, err := num.FindRoot(func(x float64) (float64, error) {
+			y, err := dense(x)
+			if err != nil {
+				return 0, err
+			}
+			return ev.G(x, y)
+		}, a.X, b.X, eventTol*math.Max(1, math.Abs(b.X)))
+		switch {
+		case errors.Cause(err) == num.ErrNotBracketed:
+			// the approximation between the steps may not change its sign near the ends
+			// of the step, so the root is within the local error from the right end
+			x = b.X
+		case err != nil:
+			return nil, nil, errors.Wrapf(err, "failed to locate event %s between x=%.4f and x=%.4f", ev.Name, a.X, b.X)
+		}
+
+		y := b.Y
+		if x != b.X {
+			if y, err = dense(x); err != nil {
+				return nil, nil, err
+			}
+		}
+		roots = append(roots, EventPoint{Event: ev.Name, X: x, Y: y, Terminal: ev.Terminal})
+	}
+
+	sort.SliceStable(roots, func(i, j int) bool { return math.Abs(roots[i].X-a.X) < math.Abs(roots[j].X-a.X) })
+	return roots, gNext, nil
+}
+
+// crossed reports, whether the event function changes its sign from ga to gb in the given
+// direction, the root at the left end is not counted, as it is counted on the previous step
+func crossed(ga, gb float64, dir int) bool {
+	switch {
+	case ga < 0 && gb >= 0:
+		return dir >= 0
+	case ga > 0 && gb <= 0:
+		return dir <= 0
+	}
+	return false
+}
+
+// denseOutput returns the approximation of the solution on the step from a to b
+func denseOutput(f func(x, y float64) (float64, error), a, b num.Point) (func(x float64) (float64, error), error) {
+	fa, err := f(a.X, a.Y)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to calculate f for x=%.4f y=%.4f", a.X, a.Y)
+	}
+	fb, err := f(b.X, b.Y)
+	if err != nil || math.IsNaN(b.Y+fb) || math.IsInf(b.Y+fb, 0) {
+		rk := &Explicit{F: f, Tableau: builtinTableaux[TableauRK4]}
+		return func(x float64) (float64, error) { return rk.Step(x-a.X, []num.Point{a}) }, nil
+	}
+
+	h := b.X - a.X
+	return func(x float64) (float64, error) {
+		t := (x - a.X) / h
+		t2, t3 := t*t, t*t*t
+		return (2*t3-3*t2+1)*a.Y + (t3-2*t2+t)*h*fa + (-2*t3+3*t2)*b.Y + (t3-t2)*h*fb, nil
+	}, nil
+}
@@ -17,20 +17,25 @@ type Exact struct {
 
 // Solve just plots the graph, without applying any algorithm
 func (e *Exact) Solve(stepSize, x0, y0, xEnd float64) (num.Line, error) {
-	x := x0
-	y := y0
+	return e.SolveAt(x0, y0, num.Grid(stepSize, x0, xEnd))
+}
+
+// SolveAt calculates the exact solution at the given abscissas
+func (e *Exact) SolveAt(x0, y0 float64, xs []float64) (num.Line, error) {
 	c, err := e.C(x0, y0)
 	if err != nil {
 		return num.Line{}, errors.Wrapf(err, "failed to calculate constant for x0=%.4f, y0=%.4f", x0, y0)
 	}
 
-	var pts []num.Point
-	for x <= xEnd {
-		pts = append(pts, num.Point{X: x, Y: y})
-		x += stepSize
-		if y, err = e.F(x, c); err != nil {
-			return num.Line{}, errors.Wrapf(err, "failed to calculate y for x=%.4f, c=%.4f", x, c)
+	pts := make([]num.Point, 0, len(xs))
+	for _, x := range xs {
+		y := y0
+		if x != x0 {
+			if y, err = e.F(x, c); err != nil {
+				return num.Line{}, errors.Wrapf(err, "failed to calculate y for x=%.4f, c=%.4f", x, c)
+			}
 		}
+		pts = append(pts, num.Point{X: x, Y: y})
 	}
 
 	return num.Line{Name: "Exact solution", Points: pts}, nil
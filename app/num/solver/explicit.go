@@ -0,0 +1,186 @@
+package solver
+
+import (
+	"github.com/Semior001/decompract/app/num"
+	log "github.com/go-pkgz/lgr"
+	"github.com/pkg/errors"
+)
+
+// Explicit is a generic explicit Runge-Kutta method for solving initial value
+// problem for differential equations, driven by the Butcher tableau
+type Explicit struct {
+	F       func(x, y float64) (float64, error)               // calculator for f(x,y) = y'
+	Sys     func(x float64, y num.Vector) (num.Vector, error) // calculator for F(x,y) = y' of the system
+	Tableau Tableau
+}
+
+// NewExplicit makes the explicit Runge-Kutta solver with the built-in or
+// registered tableau with the given name
+func NewExplicit(tableau string, f func(x, y float64) (float64, error)) (*Explicit, error) {
+	t, ok := LookupTableau(tableau)
+	if !ok {
+		return nil, errors.Errorf("tableau %s is not registered", tableau)
+	}
+	return &Explicit{F: f, Tableau: t}, nil
+}
+
+// NewExplicitSystem makes the explicit Runge-Kutta solver for the system of
+// equations with the built-in or registered tableau with the given name
+func NewExplicitSystem(tableau string, sys func(x float64, y num.Vector) (num.Vector, error)) (*Explicit, error) {
+	t, ok := LookupTableau(tableau)
+	if !ok {
+		return nil, errors.Errorf("tableau %s is not registered", tableau)
+	}
+	return &Explicit{Sys: sys, Tableau: t}, nil
+}
+
+// NewEuler makes Euler's method, which is y_{i+1} = y_i + h * f(x_i, y_i)
+func NewEuler(f func(x, y float64) (float64, error)) *Explicit {
+	return &Explicit{F: f, Tableau: builtinTableaux[TableauEuler].copy()}
+}
+
+// NewImprovedEuler makes the improved Euler's method, which is the explicit midpoint one
+// y_{i+1} = y_i + h*f(x_i + h/2, y_i + f(x_i, y_i) * h/2)
+func NewImprovedEuler(f func(x, y float64) (float64, error)) *Explicit {
+	return &Explicit{F: f, Tableau: builtinTableaux[TableauImprovedEuler].copy()}
+}
+
+// NewRungeKutta makes the classic 4th order Runge-Kutta method
+func NewRungeKutta(f func(x, y float64) (float64, error)) *Explicit {
+	return &Explicit{F: f, Tableau: builtinTableaux[TableauRK4].copy()}
+}
+
+// Solve the initial value problem with the method described by the tableau
+func (e *Explicit) Solve(stepSize, x0, y0, xEnd float64) (num.Line, error) {
+	if err := e.Tableau.Validate(); err != nil {
+		return num.Line{}, errors.Wrapf(err, "invalid tableau of %s", e.Tableau.Name)
+	}
+
+	y := y0
+	var err error
+
+	log.Printf("[DEBUG] starting solving the equation with %s "+
+		"with stepsz = %.4f, x0 = %.4f, y0 = %.4f, xend = %.4f", e.Tableau.Name, stepSize, x0, y0, xEnd)
+
+	k := make([]float64, e.Tableau.Stages())
+	xs := num.Grid(stepSize, x0, xEnd)
+	pts := make([]num.Point, 0, len(xs))
+	for i, x := range xs {
+		if i > 0 {
+			if y, err = e.step(k, stepSize, xs[i-1], y); err != nil {
+				return num.Line{}, err
+			}
+		}
+		pts = append(pts, num.Point{X: x, Y: y})
+	}
+
+	return num.Line{Name: e.Tableau.Name, Points: pts}, nil
+}
+
+// Name returns the name of the tableau
+func (e *Explicit) Name() string { return e.Tableau.Name }
+
+// Steps returns the number of previous points, required by the method,
+// which is always 1 for Runge-Kutta methods
+func (e *Explicit) Steps() int { return 1 }
+
+// Step makes a single step of the size h from the last of the given points
+func (e *Explicit) Step(h float64, prev []num.Point) (float64, error) {
+	if err := e.Tableau.Validate(); err != nil {
+		return 0, errors.Wrapf(err, "invalid tableau of %s", e.Tableau.Name)
+	}
+	last := prev[len(prev)-1]
+	return e.step(make([]float64, e.Tableau.Stages()), h, last.X, last.Y)
+}
+
+// step calculates the next y value as
+// y_{i+1} = y_i + h * \sum_j b_j k_j, where
+// k_j = f(x_i + c_j h, y_i + h \sum_l a_jl k_l),
+// k is a buffer for stages, to avoid allocations on each step
+func (e *Explicit) step(k []float64, h, xi, yi float64) (float64, error) {
+	var err error
+	t := e.Tableau
+
+	for j := range k {
+		y := yi
+		for l := 0; l < j && l < len(t.A[j]); l++ {
+			y += h * t.A[j][l] * k[l]
+		}
+		if k[j], err = e.F(xi+t.C[j]*h, y); err != nil {
+			return 0, errors.Wrapf(err, "failed to calculate k%d for h=%.4f, x=%.4f, y=%.4f", j+1, h, xi, yi)
+		}
+	}
+
+	dy := 0.0
+	for j := range k {
+		dy += t.B[j] * k[j]
+	}
+	return yi + h*dy, nil
+}
+
+// SolveSystem solves the initial value problem for the system of equations,
+// the method is applied component-wise
+func (e *Explicit) SolveSystem(stepSize, x0 float64, y0 num.Vector, xEnd float64) (num.VecLine, error) {
+	if err := e.Tableau.Validate(); err != nil {
+		return num.VecLine{}, errors.Wrapf(err, "invalid tableau of %s", e.Tableau.Name)
+	}
+	if e.Sys == nil {
+		return num.VecLine{}, errors.Errorf("%s has no calculator for the system", e.Tableau.Name)
+	}
+	if len(y0) == 0 {
+		return num.VecLine{}, errors.New("initial state is empty")
+	}
+
+	y := append(num.Vector(nil), y0...)
+	var err error
+
+	log.Printf("[DEBUG] starting solving the system of %d equations with %s "+
+		"with stepsz = %.4f, x0 = %.4f, y0 = %v, xend = %.4f", len(y0), e.Tableau.Name, stepSize, x0, y0, xEnd)
+
+	k := make([]num.Vector, e.Tableau.Stages())
+	xs := num.Grid(stepSize, x0, xEnd)
+	pts := make([]num.VecPoint, 0, len(xs))
+	for i, x := range xs {
+		if i > 0 {
+			if y, err = e.stepSystem(k, stepSize, xs[i-1], y); err != nil {
+				return num.VecLine{}, err
+			}
+		}
+		pts = append(pts, num.VecPoint{X: x, Y: y})
+	}
+
+	return num.VecLine{Name: e.Tableau.Name, Points: pts}, nil
+}
+
+// stepSystem calculates the next state of the system component-wise in the
+// same way as step does, returns the new vector, so the previous states
+// could be safely stored
+func (e *Explicit) stepSystem(k []num.Vector, h, xi float64, yi num.Vector) (num.Vector, error) {
+	var err error
+	t := e.Tableau
+
+	y := make(num.Vector, len(yi))
+	for j := range k {
+		copy(y, yi)
+		for l := 0; l < j && l < len(t.A[j]); l++ {
+			for c := range y {
+				y[c] += h * t.A[j][l] * k[l][c]
+			}
+		}
+		if k[j], err = e.Sys(xi+t.C[j]*h, y); err != nil {
+			return nil, errors.Wrapf(err, "failed to calculate k%d for h=%.4f, x=%.4f, y=%v", j+1, h, xi, yi)
+		}
+		if len(k[j]) != len(yi) {
+			return nil, errors.Errorf("dimension of F(x,y)=%d is not equal to the dimension of the state %d",
+				len(k[j]), len(yi))
+		}
+	}
+
+	res := append(num.Vector(nil), yi...)
+	for j := range k {
+		for c := range res {
+			res[c] += h * t.B[j] * k[j][c]
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,223 @@
+package solver
+
+import (
+	"math"
+
+	"github.com/Semior001/decompract/app/num"
+	log "github.com/go-pkgz/lgr"
+	"github.com/pkg/errors"
+)
+
+// parameters of the Newton iteration, used by implicit methods
+const (
+	newtonTol     = 1e-12
+	newtonMaxIter = 50
+)
+
+// BackwardEuler is an implicit Euler method for solving initial value problem
+// for differential equations, suitable for the stiff equations
+type BackwardEuler struct {
+	F    func(x, y float64) (float64, error) // calculator for f(x,y) = y'
+	DFDY func(x, y float64) (float64, error) // calculator for ∂f/∂y, approximated numerically if not set
+}
+
+// Solve the initial value problem with backward Euler method, which is
+// y_{i+1} = y_i + h * f(x_{i+1}, y_{i+1})
+func (b *BackwardEuler) Solve(stepSize, x0, y0, xEnd float64) (num.Line, error) {
+	log.Printf("[DEBUG] starting solving the equation with backward Euler's "+
+		"method with stepsz = %.4f, x0 = %.4f, y0 = %.4f, xend = %.4f", stepSize, x0, y0, xEnd)
+
+	xs := num.Grid(stepSize, x0, xEnd)
+	pts := make([]num.Point, 0, len(xs))
+	for i, x := range xs {
+		y := y0
+		if i > 0 {
+			var err error
+			if y, err = b.Step(stepSize, pts[i-1:]); err != nil {
+				return num.Line{}, errors.Wrapf(err, "failed to make a step at x=%.4f", xs[i-1])
+			}
+		}
+		pts = append(pts, num.Point{X: x, Y: y})
+	}
+
+	return num.Line{Name: b.Name(), Points: pts}, nil
+}
+
+// Name returns "Backward Euler's method"
+func (b *BackwardEuler) Name() string { return "Backward Euler's method" }
+
+// Steps returns the number of previous points, required by the method
+func (b *BackwardEuler) Steps() int { return 1 }
+
+// Step makes a single step of the size h from the last of the given points,
+// the explicit Euler step is used as an initial guess
+func (b *BackwardEuler) Step(h float64, prev []num.Point) (float64, error) {
+	last := prev[len(prev)-1]
+	fi, err := b.F(last.X, last.Y)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to calculate f for x=%.4f y=%.4f", last.X, last.Y)
+	}
+	return newton{f: b.F, dfdy: b.DFDY}.solve(last.X+h, last.Y, h, last.Y+h*fi)
+}
+
+// Trapezoidal is an implicit trapezoidal rule (Crank-Nicolson method) for solving
+// initial value problem for differential equations
+type Trapezoidal struct {
+	F    func(x, y float64) (float64, error) // calculator for f(x,y) = y'
+	DFDY func(x, y float64) (float64, error) // calculator for ∂f/∂y, approximated numerically if not set
+}
+
+// Solve the initial value problem with the trapezoidal rule, which is
+// y_{i+1} = y_i + h/2 * (f(x_i, y_i) + f(x_{i+1}, y_{i+1}))
+func (t *Trapezoidal) Solve(stepSize, x0, y0, xEnd float64) (num.Line, error) {
+	log.Printf("[DEBUG] starting solving the equation with trapezoidal "+
+		"rule with stepsz = %.4f, x0 = %.4f, y0 = %.4f, xend = %.4f", stepSize, x0, y0, xEnd)
+
+	xs := num.Grid(stepSize, x0, xEnd)
+	pts := make([]num.Point, 0, len(xs))
+	for i, x := range xs {
+		y := y0
+		if i > 0 {
+			var err error
+			if y, err = t.Step(stepSize, pts[i-1:]); err != nil {
+				return num.Line{}, errors.Wrapf(err, "failed to make a step at x=%.4f", xs[i-1])
+			}
+		}
+		pts = append(pts, num.Point{X: x, Y: y})
+	}
+
+	return num.Line{Name: t.Name(), Points: pts}, nil
+}
+
+// Name returns "Trapezoidal rule"
+func (t *Trapezoidal) Name() string { return "Trapezoidal rule" }
+
+// Steps returns the number of previous points, required by the method
+func (t *Trapezoidal) Steps() int { return 1 }
+
+// Step makes a single step of the size h from the last of the given points
+func (t *Trapezoidal) Step(h float64, prev []num.Point) (float64, error) {
+	last := prev[len(prev)-1]
+	return newton{f: t.F, dfdy: t.DFDY}.trapezoidalStep(h, last.X, last.Y)
+}
+
+// BDF2 is an implicit two-step backward differentiation formula for solving
+// initial value problem for differential equations, the first step is made
+// with the trapezoidal rule, as it has the same order of accuracy
+type BDF2 struct {
+	F    func(x, y float64) (float64, error) // calculator for f(x,y) = y'
+	DFDY func(x, y float64) (float64, error) // calculator for ∂f/∂y, approximated numerically if not set
+}
+
+// Solve the initial value problem with BDF2, which is
+// y_{i+1} = 4/3 * y_i - 1/3 * y_{i-1} + 2/3 * h * f(x_{i+1}, y_{i+1})
+func (b *BDF2) Solve(stepSize, x0, y0, xEnd float64) (num.Line, error) {
+	log.Printf("[DEBUG] starting solving the equation with BDF2 "+
+		"method with stepsz = %.4f, x0 = %.4f, y0 = %.4f, xend = %.4f", stepSize, x0, y0, xEnd)
+
+	xs := num.Grid(stepSize, x0, xEnd)
+	pts := make([]num.Point, 0, len(xs))
+	for i, x := range xs {
+		y := y0
+		if i > 0 {
+			from := i - b.Steps()
+			if from < 0 {
+				from = 0
+			}
+
+			var err error
+			if y, err = b.Step(stepSize, pts[from:]); err != nil {
+				return num.Line{}, errors.Wrapf(err, "failed to make a step at x=%.4f", xs[i-1])
+			}
+		}
+		pts = append(pts, num.Point{X: x, Y: y})
+	}
+
+	return num.Line{Name: b.Name(), Points: pts}, nil
+}
+
+// Name returns "BDF2 method"
+func (b *BDF2) Name() string { return "BDF2 method" }
+
+// Steps returns the number of previous points, required by the method
+func (b *BDF2) Steps() int { return 2 }
+
+// Step makes a single step of the size h from the last two of the given points,
+// the trapezoidal rule is used, if there is only one point
+func (b *BDF2) Step(h float64, prev []num.Point) (float64, error) {
+	n := newton{f: b.F, dfdy: b.DFDY}
+	last := prev[len(prev)-1]
+	if len(prev) < 2 {
+		return n.trapezoidalStep(h, last.X, last.Y)
+	}
+
+	yPrev := prev[len(prev)-2].Y
+	// linear extrapolation of the previous values as an initial guess
+	return n.solve(last.X+h, (4*last.Y-yPrev)/3, 2*h/3, 2*last.Y-yPrev)
+}
+
+// newton solves the implicit equations of the form y = c + γ*f(x, y) with Newton iteration
+type newton struct {
+	f    func(x, y float64) (float64, error)
+	dfdy func(x, y float64) (float64, error)
+}
+
+// trapezoidalStep makes a step of the trapezoidal rule from the point (xi, yi)
+func (n newton) trapezoidalStep(h, xi, yi float64) (float64, error) {
+	fi, err := n.f(xi, yi)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to calculate f for x=%.4f y=%.4f", xi, yi)
+	}
+	return n.solve(xi+h, yi+h/2*fi, h/2, yi+h*fi)
+}
+
+// solve finds y, such that y = c + γ*f(x, y), starting from the given guess
+func (n newton) solve(x, c, gamma, guess float64) (float64, error) {
+	y := guess
+	for i := 0; i < newtonMaxIter; i++ {
+		f, err := n.f(x, y)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to calculate f for x=%.4f y=%.4f", x, y)
+		}
+
+		df, err := n.derivative(x, y)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to calculate df/dy for x=%.4f y=%.4f", x, y)
+		}
+
+		g, dg := y-c-gamma*f, 1-gamma*df
+		if dg == 0 {
+			return 0, errors.Errorf("newton iteration has zero derivative at x=%.4f y=%.4f", x, y)
+		}
+
+		dy := g / dg
+		y -= dy
+		if math.IsNaN(y) || math.IsInf(y, 0) {
+			return 0, errors.Errorf("newton iteration diverged at x=%.4f", x)
+		}
+		if math.Abs(dy) <= newtonTol*math.Max(1, math.Abs(y)) {
+			return y, nil
+		}
+	}
+	return 0, errors.Errorf("newton iteration did not converge in %d iterations at x=%.4f, y=%.4f",
+		newtonMaxIter, x, y)
+}
+
+// derivative calculates ∂f/∂y with the given calculator or approximates
+// it with the central finite difference
+func (n newton) derivative(x, y float64) (float64, error) {
+	if n.dfdy != nil {
+		return n.dfdy(x, y)
+	}
+
+	d := math.Cbrt(2.2e-16) * math.Max(1, math.Abs(y))
+	fp, err := n.f(x, y+d)
+	if err != nil {
+		return 0, err
+	}
+	fm, err := n.f(x, y-d)
+	if err != nil {
+		return 0, err
+	}
+	return (fp - fm) / (2 * d), nil
+}
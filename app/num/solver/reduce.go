@@ -0,0 +1,32 @@
+package solver
+
+import (
+	"github.com/Semior001/decompract/app/num"
+	"github.com/pkg/errors"
+)
+
+// ReduceOrder reduces the n-th order equation y^(n) = f(x, y, y', ..., y^(n-1))
+// to the system of n first order equations by substitution
+// y1 = y, y2 = y', ..., yn = y^(n-1), so the state of the system is
+// (y, y', ..., y^(n-1)) and f receives it as the second argument
+func ReduceOrder(order int, f func(x float64, y num.Vector) (float64, error)) (
+	func(x float64, y num.Vector) (num.Vector, error), error) {
+	if order < 1 {
+		return nil, errors.Errorf("order of the equation must be positive, got %d", order)
+	}
+
+	return func(x float64, y num.Vector) (num.Vector, error) {
+		if len(y) != order {
+			return nil, errors.Errorf("dimension of the state %d is not equal to the order of equation %d", len(y), order)
+		}
+
+		res := make(num.Vector, order)
+		copy(res, y[1:])
+
+		var err error
+		if res[order-1], err = f(x, y); err != nil {
+			return nil, errors.Wrapf(err, "failed to calculate y^(%d) for x=%.4f, y=%v", order, x, y)
+		}
+		return res, nil
+	}, nil
+}
@@ -0,0 +1,65 @@
+package solver
+
+import (
+	"math"
+
+	"github.com/Semior001/decompract/app/num"
+	"github.com/pkg/errors"
+)
+
+// default tolerances of the reference solution
+const (
+	referenceAbsTol = 1e-12
+	referenceRelTol = 1e-12
+)
+
+// Reference calculates the high-accuracy numerical solution with Dormand-Prince
+// method with tight tolerances, it is used in place of the exact solution,
+// when the equation has no closed form solution
+type Reference struct {
+	F      func(x, y float64) (float64, error) // calculator for f(x,y) = y'
+	AbsTol float64                             // absolute tolerance, 1e-12 if not set
+	RelTol float64                             // relative tolerance, 1e-12 if not set
+}
+
+// Solve calculates the reference solution on the uniform grid with the given step size
+func (r *Reference) Solve(stepSize, x0, y0, xEnd float64) (num.Line, error) {
+	return r.SolveAt(x0, y0, num.Grid(stepSize, x0, xEnd))
+}
+
+// SolveAt calculates the reference solution at the given abscissas, which must go
+// monotonically from x0 in either direction, the solution is integrated from one
+// abscissa to the next, so the adaptive solver lands exactly on each of them
+func (r *Reference) SolveAt(x0, y0 float64, xs []float64) (num.Line, error) {
+	atol, rtol := r.AbsTol, r.RelTol
+	if atol <= 0 {
+		atol = referenceAbsTol
+	}
+	if rtol <= 0 {
+		rtol = referenceRelTol
+	}
+	dp := DormandPrince{F: r.F, AbsTol: atol, RelTol: rtol}
+
+	x, y := x0, y0
+	dir := 0.0 // direction of integration, defined by the first abscissa, distinct from x0
+	pts := make([]num.Point, 0, len(xs))
+	for _, xi := range xs {
+		if xi != x {
+			if dir == 0 {
+				dir = math.Copysign(1, xi-x)
+			}
+			if math.Copysign(1, xi-x) != dir {
+				return num.Line{}, errors.Errorf("abscissas must go monotonically from x0=%.4f, got %.4f after %.4f",
+					x0, xi, x)
+			}
+			line, err := dp.Solve(xi-x, x, y, xi)
+			if err != nil {
+				return num.Line{}, errors.Wrapf(err, "failed to calculate reference solution at x=%.4f", xi)
+			}
+			x, y = xi, line.Points[len(line.Points)-1].Y
+		}
+		pts = append(pts, num.Point{X: xi, Y: y})
+	}
+
+	return num.Line{Name: "Reference solution (numerical)", Points: pts}, nil
+}
@@ -0,0 +1,188 @@
+package solver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Semior001/decompract/app/num"
+	"github.com/pkg/errors"
+)
+
+// Constructor makes the solver for the equation y' = f(x,y)
+type Constructor func(f func(x, y float64) (float64, error)) Interface
+
+// names of the built-in methods, explicit Runge-Kutta methods
+// are also available by the names of their tableaux
+const (
+	MethodEuler         = TableauEuler
+	MethodImprovedEuler = TableauImprovedEuler
+	MethodRK4           = TableauRK4
+	MethodDormandPrince = "dopri5"
+	MethodBackwardEuler = "backward-euler"
+	MethodTrapezoidal   = "trapezoidal"
+	MethodBDF2          = "bdf2"
+	MethodTaylor2       = "taylor2"
+	MethodTaylor3       = "taylor3"
+)
+
+// DefaultMethods are used when the methods are not specified explicitly
+var DefaultMethods = []string{MethodRK4, MethodImprovedEuler, MethodEuler}
+
+// registry keeps the registered methods and custom tableaux under the same lock,
+// so a name can't be registered both as a method and as a tableau
+var registry = struct {
+	sync.RWMutex
+	m        map[string]Constructor
+	tableaux map[string]Tableau
+}{m: builtinMethods(), tableaux: map[string]Tableau{}}
+
+func builtinMethods() map[string]Constructor {
+	res := map[string]Constructor{
+		MethodDormandPrince: func(f func(x, y float64) (float64, error)) Interface { return &DormandPrince{F: f} },
+		MethodBackwardEuler: func(f func(x, y float64) (float64, error)) Interface { return &BackwardEuler{F: f} },
+		MethodTrapezoidal:   func(f func(x, y float64) (float64, error)) Interface { return &Trapezoidal{F: f} },
+		MethodBDF2:          func(f func(x, y float64) (float64, error)) Interface { return &BDF2{F: f} },
+		MethodTaylor2:       func(f func(x, y float64) (float64, error)) Interface { return &Taylor{F: f, Order: 2} },
+		MethodTaylor3:       func(f func(x, y float64) (float64, error)) Interface { return &Taylor{F: f, Order: 3} },
+	}
+
+	for order := range abCoeffs {
+		order := order
+		res[fmt.Sprintf("ab%d", order)] = func(f func(x, y float64) (float64, error)) Interface {
+			return &AdamsBashforth{F: f, Order: order}
+		}
+		res[fmt.Sprintf("am%d", order)] = func(f func(x, y float64) (float64, error)) Interface {
+			return &AdamsMoulton{F: f, Order: order}
+		}
+		res[fmt.Sprintf("abm%d", order)] = func(f func(x, y float64) (float64, error)) Interface {
+			return &AdamsBashforthMoulton{F: f, Order: order}
+		}
+	}
+	return res
+}
+
+// Register registers the constructor of the solver with the given name,
+// so it can be made later with New, already registered methods can't be overridden
+func Register(name string, c Constructor) error {
+	if name == "" {
+		return errors.New("method name is empty")
+	}
+	if c == nil {
+		return errors.Errorf("constructor of %s is nil", name)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	if err := checkNameFree(name); err != nil {
+		return err
+	}
+	registry.m[name] = c
+	return nil
+}
+
+// checkNameFree returns an error if the name is already taken by a method or a tableau,
+// registry must be locked by the caller
+func checkNameFree(name string) error {
+	if _, ok := registry.m[name]; ok {
+		return errors.Errorf("%s is already registered as a method", name)
+	}
+	if _, ok := builtinTableaux[name]; ok {
+		return errors.Errorf("%s is already registered as a built-in tableau", name)
+	}
+	if _, ok := registry.tableaux[name]; ok {
+		return errors.Errorf("%s is already registered as a tableau", name)
+	}
+	return nil
+}
+
+// New makes the solver of the method with the given name for the equation y' = f(x,y),
+// methods are looked up in registered constructors and then in registered tableaux
+func New(name string, f func(x, y float64) (float64, error)) (Interface, error) {
+	registry.RLock()
+	c, ok := registry.m[name]
+	registry.RUnlock()
+	if ok {
+		return c(f), nil
+	}
+
+	if t, ok := LookupTableau(name); ok {
+		return &Explicit{F: f, Tableau: t}, nil
+	}
+	return nil, errors.Errorf("unknown method %s", name)
+}
+
+// NewSet makes the solvers of the methods with the given names for the equation y' = f(x,y)
+func NewSet(names []string, f func(x, y float64) (float64, error)) ([]Interface, error) {
+	if len(names) == 0 {
+		return nil, errors.New("no methods specified")
+	}
+
+	res := make([]Interface, 0, len(names))
+	for _, name := range names {
+		s, err := New(name, f)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+// NewSystem makes the solver of the method with the given name for the system of
+// equations y' = F(x,y), only explicit Runge-Kutta methods support systems
+func NewSystem(name string, sys func(x float64, y num.Vector) (num.Vector, error)) (SystemInterface, error) {
+	if t, ok := LookupTableau(name); ok {
+		return &Explicit{Sys: sys, Tableau: t}, nil
+	}
+	return nil, errors.Errorf("method %s doesn't support systems of equations", name)
+}
+
+// NewSystemSet makes the solvers of the methods with the given names for the system of equations y' = F(x,y)
+func NewSystemSet(names []string, sys func(x float64, y num.Vector) (num.Vector, error)) ([]SystemInterface, error) {
+	if len(names) == 0 {
+		return nil, errors.New("no methods specified")
+	}
+
+	res := make([]SystemInterface, 0, len(names))
+	for _, name := range names {
+		s, err := NewSystem(name, sys)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+// Methods returns the sorted list of names of all available methods
+func Methods() []string {
+	names := map[string]bool{}
+	registry.RLock()
+	for name := range registry.m {
+		names[name] = true
+	}
+	registry.RUnlock()
+	for _, name := range Tableaux() {
+		names[name] = true
+	}
+
+	res := make([]string, 0, len(names))
+	for name := range names {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// ParseMethods splits the comma-separated list of methods, e.g. "rk4,heun,euler"
+func ParseMethods(s string) []string {
+	var res []string
+	for _, name := range strings.Split(s, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			res = append(res, name)
+		}
+	}
+	return res
+}
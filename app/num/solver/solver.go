@@ -9,3 +9,45 @@ import (
 type Interface interface {
 	Solve(stepSize, x0, y0, xEnd float64) (line num.Line, err error)
 }
+
+// Pointwise describes solvers, that are able to calculate the solution
+// at the arbitrary given abscissas, e.g. to compare it with the solutions
+// on the non-uniform grids
+type Pointwise interface {
+	Interface
+	SolveAt(x0, y0 float64, xs []float64) (line num.Line, err error)
+}
+
+// Stepper describes solvers, that are able to make a single step from the given
+// points of the solution, e.g. to calculate the local truncation error by
+// restarting each step from the exact solution
+type Stepper interface {
+	Interface
+	// Name returns the name of the method, which is the name of its solution line
+	Name() string
+	// Steps returns the number of previous points, required by the method
+	Steps() int
+	// Step calculates y(x_i + h) by the last points of the solution, where the last
+	// one is (x_i, y_i), if there are less than Steps() points, the starting
+	// method is used, as on the first steps of Solve
+	Step(h float64, prev []num.Point) (float64, error)
+}
+
+// Adaptive is implemented by solvers, that may choose the step sizes on their own,
+// e.g. the Dormand-Prince method
+type Adaptive interface {
+	// Adaptive returns true if the given step size is only the initial guess
+	Adaptive() bool
+}
+
+// IsAdaptive returns true if the solver chooses the step sizes on its own
+func IsAdaptive(s Interface) bool {
+	a, ok := s.(Adaptive)
+	return ok && a.Adaptive()
+}
+
+// SystemInterface describes methods that the solver should implement in order
+// to solve the Initial Value problem for the system of equations y' = F(x, y)
+type SystemInterface interface {
+	SolveSystem(stepSize, x0 float64, y0 num.Vector, xEnd float64) (line num.VecLine, err error)
+}
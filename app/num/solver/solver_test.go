@@ -1,12 +1,14 @@
 package solver
 
 import (
+	"fmt"
 	"math"
 	"testing"
 
 	"github.com/Semior001/decompract/app/num"
 
 	log "github.com/go-pkgz/lgr"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -20,56 +22,56 @@ func TestSolvers(t *testing.T) {
 		precision float64
 	}{
 		{
-			solver: &Euler{F: func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }},
+			solver: NewEuler(func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }),
 			name:   "Euler's method",
 			points: []num.Point{
-				{0.0, 1.00000},
-				{0.1, 0.80000},
-				{0.2, 0.64100},
-				{0.3, 0.51680},
-				{0.4, 0.42244},
-				{0.5, 0.35395},
-				{0.6, 0.30816},
-				{0.7, 0.28253},
-				{0.8, 0.27502},
-				{0.9, 0.28402},
-				{1.0, 0.30821},
+				{X: 0.0, Y: 1.00000},
+				{X: 0.1, Y: 0.80000},
+				{X: 0.2, Y: 0.64100},
+				{X: 0.3, Y: 0.51680},
+				{X: 0.4, Y: 0.42244},
+				{X: 0.5, Y: 0.35395},
+				{X: 0.6, Y: 0.30816},
+				{X: 0.7, Y: 0.28253},
+				{X: 0.8, Y: 0.27502},
+				{X: 0.9, Y: 0.28402},
+				{X: 1.0, Y: 0.30821},
 			},
 			precision: 0.00001,
 		},
 		{
-			solver: &ImprovedEuler{F: func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }},
+			solver: NewImprovedEuler(func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }),
 			name:   "Improved Euler's method",
 			points: []num.Point{
-				{0.0, 1.000000},
-				{0.1, 0.820250},
-				{0.2, 0.674755},
-				{0.3, 0.559149},
-				{0.4, 0.469852},
-				{0.5, 0.403929},
-				{0.6, 0.358972},
-				{0.7, 0.333007},
-				{0.8, 0.324416},
-				{0.9, 0.331871},
-				{1.0, 0.354284},
+				{X: 0.0, Y: 1.000000},
+				{X: 0.1, Y: 0.820250},
+				{X: 0.2, Y: 0.674755},
+				{X: 0.3, Y: 0.559149},
+				{X: 0.4, Y: 0.469852},
+				{X: 0.5, Y: 0.403929},
+				{X: 0.6, Y: 0.358972},
+				{X: 0.7, Y: 0.333007},
+				{X: 0.8, Y: 0.324416},
+				{X: 0.9, Y: 0.331871},
+				{X: 1.0, Y: 0.354284},
 			},
 			precision: 0.000001,
 		},
 		{
-			solver: &RungeKutta{F: func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }},
+			solver: NewRungeKutta(func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }),
 			name:   "Runge-Kutta's method",
 			points: []num.Point{
-				{0.0, 1.000000},
-				{0.1, 0.819051},
-				{0.2, 0.672745},
-				{0.3, 0.556615},
-				{0.4, 0.467004},
-				{0.5, 0.400917},
-				{0.6, 0.355903},
-				{0.7, 0.329955},
-				{0.8, 0.321430},
-				{0.9, 0.328982},
-				{1.0, 0.351509},
+				{X: 0.0, Y: 1.000000},
+				{X: 0.1, Y: 0.819051},
+				{X: 0.2, Y: 0.672745},
+				{X: 0.3, Y: 0.556615},
+				{X: 0.4, Y: 0.467004},
+				{X: 0.5, Y: 0.400917},
+				{X: 0.6, Y: 0.355903},
+				{X: 0.7, Y: 0.329955},
+				{X: 0.8, Y: 0.321430},
+				{X: 0.9, Y: 0.328982},
+				{X: 1.0, Y: 0.351509},
 			},
 			precision: 0.000001,
 		},
@@ -92,23 +94,23 @@ func TestExact_Solve(t *testing.T) {
 		C: func(x0, y0 float64) (float64, error) { return (math.Exp(-x0) - y0) / (y0 * math.Exp(x0)), nil }, // 2926.3598370085842
 	}
 	points := []num.Point{
-		{-4.0, 1.00000000},
-		{-3.5, 0.37054986},
-		{-3.0, 0.13692051},
-		{-2.5, 0.05050571},
-		{-2.0, 0.01861037},
-		{-1.5, 0.00685316},
-		{-1.0, 0.00252266},
-		{-0.5, 0.00092837},
-		{+0.0, 0.00034160},
-		{+0.5, 0.00012569},
-		{+1.0, 0.00004624},
-		{+1.5, 0.00001701},
-		{+2.0, 0.00000626},
-		{+2.5, 0.00000230},
-		{+3.0, 0.00000085},
-		{+3.5, 0.00000031},
-		{+4.0, 0.00000011},
+		{X: -4.0, Y: 1.00000000},
+		{X: -3.5, Y: 0.37054986},
+		{X: -3.0, Y: 0.13692051},
+		{X: -2.5, Y: 0.05050571},
+		{X: -2.0, Y: 0.01861037},
+		{X: -1.5, Y: 0.00685316},
+		{X: -1.0, Y: 0.00252266},
+		{X: -0.5, Y: 0.00092837},
+		{X: +0.0, Y: 0.00034160},
+		{X: +0.5, Y: 0.00012569},
+		{X: +1.0, Y: 0.00004624},
+		{X: +1.5, Y: 0.00001701},
+		{X: +2.0, Y: 0.00000626},
+		{X: +2.5, Y: 0.00000230},
+		{X: +3.0, Y: 0.00000085},
+		{X: +3.5, Y: 0.00000031},
+		{X: +4.0, Y: 0.00000011},
 	}
 
 	line, err := e.Solve(0.5, -4, 1, 4)
@@ -121,10 +123,828 @@ func TestExact_Solve(t *testing.T) {
 	}
 }
 
+func TestExact_SolveAt(t *testing.T) {
+	e := &Exact{
+		F: func(x, c float64) (float64, error) { return math.Exp(-x) / (c*math.Exp(x) + 1), nil },
+		C: func(x0, y0 float64) (float64, error) { return (math.Exp(-x0) - y0) / (y0 * math.Exp(x0)), nil },
+	}
+
+	line, err := e.SolveAt(-4, 1, []float64{-4, -3.7, -1, 2.5})
+	require.NoError(t, err)
+	assert.Equal(t, "Exact solution", line.Name)
+	require.Equal(t, 4, len(line.Points))
+
+	expected := []num.Point{
+		{X: -4.0, Y: 1.00000000},
+		{X: -3.7, Y: 0.55142932},
+		{X: -1.0, Y: 0.00252266},
+		{X: +2.5, Y: 0.00000230},
+	}
+	for i := range line.Points {
+		assert.Equal(t, expected[i].X, line.Points[i].X, "step: %d", i)
+		assert.InDelta(t, expected[i].Y, line.Points[i].Y, 0.00000001, "step: %d", i)
+	}
+}
+
+func TestReference_SolveAt(t *testing.T) {
+	r := &Reference{F: func(x, y float64) (float64, error) { return y*y*math.Exp(x) - 2.0*y, nil }}
+	e := &Exact{
+		F: func(x, c float64) (float64, error) { return math.Exp(-x) / (c*math.Exp(x) + 1), nil },
+		C: func(x0, y0 float64) (float64, error) { return (math.Exp(-x0) - y0) / (y0 * math.Exp(x0)), nil },
+	}
+
+	xs := []float64{-4, -3.7, -3.7, -1, 2.5}
+	line, err := r.SolveAt(-4, 1, xs)
+	require.NoError(t, err)
+	assert.Equal(t, "Reference solution (numerical)", line.Name)
+
+	exact, err := e.SolveAt(-4, 1, xs)
+	require.NoError(t, err)
+	require.Equal(t, len(exact.Points), len(line.Points))
+	for i := range line.Points {
+		assert.Equal(t, xs[i], line.Points[i].X, "step: %d", i)
+		assert.InDelta(t, exact.Points[i].Y, line.Points[i].Y, 1e-10, "step: %d", i)
+	}
+
+	line, err = r.Solve(0.5, -4, 1, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 17, len(line.Points))
+
+	// backwards from the end point to the initial one, the errors grow in this direction
+	back, err := r.SolveAt(2.5, exact.Points[4].Y, []float64{2.5, -1, -3.7, -4})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(back.Points))
+	assert.InEpsilon(t, exact.Points[3].Y, back.Points[1].Y, 1e-6)
+	assert.InEpsilon(t, 1, back.Points[3].Y, 1e-6)
+
+	_, err = r.SolveAt(-4, 1, []float64{-3, -3.5})
+	assert.Error(t, err)
+}
+
+func TestDormandPrince_Solve(t *testing.T) {
+	// y' = x^2 - 2y, y(0) = 1
+	exact := func(x float64) float64 { return x*x/2 - x/2 + 0.25 + 0.75*math.Exp(-2*x) }
+	fxy := func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }
+
+	for _, tol := range []float64{1e-4, 1e-6, 1e-9} {
+		d := &DormandPrince{F: fxy, AbsTol: tol, RelTol: tol}
+		line, err := d.Solve(0.1, 0, 1, 5)
+		require.NoError(t, err)
+		assert.Equal(t, "Dormand-Prince's method", line.Name)
+		assert.Equal(t, num.Point{X: 0, Y: 1}, line.Points[0])
+		assert.Equal(t, 5.0, line.Points[len(line.Points)-1].X, "must land exactly on the end of the interval")
+
+		for i, p := range line.Points {
+			if i > 0 {
+				assert.Greater(t, p.X, line.Points[i-1].X, "tol: %g, step: %d", tol, i)
+			}
+			assert.InDelta(t, exact(p.X), p.Y, 100*tol, "tol: %g, step: %d", tol, i)
+		}
+	}
+
+	// the step size is adapted, so the number of steps must be much less
+	// than the one required by the fixed step method with the same accuracy
+	line, err := (&DormandPrince{F: fxy}).Solve(0.001, 0, 1, 5)
+	require.NoError(t, err)
+	assert.Less(t, len(line.Points), 100)
+
+	_, err = (&DormandPrince{F: fxy, MaxSteps: 3}).Solve(0.1, 0, 1, 5)
+	assert.Error(t, err)
+}
+
 func TestPoint_String(t *testing.T) {
-	assert.Equal(t, "(0.0003, 0.1235)", num.Point{0.0003, 0.123456789}.String())
+	assert.Equal(t, "(0.0003, 0.1235)", num.Point{X: 0.0003, Y: 0.123456789}.String())
 }
 
 func TestCalculateStepSize(t *testing.T) {
 	assert.InDelta(t, 0.26667, num.CalculateStepSize(30, -4.0, 4.0), 0.00001)
 }
+
+func TestGrid(t *testing.T) {
+	for x0 := -5.0; x0 <= 5; x0 += 0.37 {
+		for _, length := range []float64{0.1, 1, math.Pi, 8, 10.3, 1e3, -0.1, -math.Pi, -10.3} {
+			xEnd := x0 + length
+			for n := 1; n <= 200; n++ {
+				h := num.CalculateStepSize(n, x0, xEnd)
+				require.Equal(t, n, num.GridSteps(h, x0, xEnd), "x0=%g, xEnd=%g, n=%d", x0, xEnd, n)
+
+				xs := num.Grid(h, x0, xEnd)
+				require.Equal(t, n+1, len(xs), "x0=%g, xEnd=%g, n=%d", x0, xEnd, n)
+				assert.Equal(t, x0, xs[0], "x0=%g, xEnd=%g, n=%d", x0, xEnd, n)
+				assert.Equal(t, xEnd, xs[n], "x0=%g, xEnd=%g, n=%d", x0, xEnd, n)
+				for i := 1; i < n; i++ {
+					if xs[i] != x0+float64(i)*h || (xs[i]-xs[i-1])*h <= 0 {
+						require.Fail(t, "wrong abscissa", "x0=%g, xEnd=%g, n=%d, xs[%d]=%g", x0, xEnd, n, i, xs[i])
+					}
+				}
+			}
+		}
+	}
+
+	// the step doesn't divide the interval, the last whole step is taken
+	xs := num.Grid(0.3, 0, 1)
+	require.Equal(t, 4, len(xs))
+	assert.InDelta(t, 0.9, xs[3], 1e-15)
+	assert.Equal(t, 3, num.GridSteps(0.3, 0, 1))
+
+	// backwards
+	assert.Equal(t, []float64{1, 0.75, 0.5, 0.25, 0}, num.Grid(-0.25, 1, 0))
+	assert.Equal(t, 4, num.GridSteps(-0.25, 1, 0))
+
+	assert.Equal(t, []float64{2}, num.Grid(0.1, 2, 2))
+	assert.Equal(t, []float64{2}, num.Grid(-0.1, 2, 2))
+	assert.Nil(t, num.Grid(0, 0, 1))
+	assert.Nil(t, num.Grid(0.1, 1, 0))
+	assert.Nil(t, num.Grid(-0.1, 0, 1))
+	assert.Equal(t, 0, num.GridSteps(-0.1, 0, 1))
+}
+
+func TestFitLine(t *testing.T) {
+	fit, err := num.FitLine([]num.Point{{X: 0, Y: 1}, {X: 1, Y: 3}, {X: 2, Y: 5}})
+	require.NoError(t, err)
+	assert.InDelta(t, 2, fit.Slope, 1e-12)
+	assert.InDelta(t, 1, fit.Intercept, 1e-12)
+	assert.InDelta(t, 0, fit.SlopeStdErr, 1e-12)
+	assert.InDelta(t, 1, fit.R2, 1e-12)
+
+	fit, err = num.FitLine([]num.Point{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 0}, {X: 3, Y: 1}})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.2, fit.Slope, 1e-12)
+	assert.Greater(t, fit.SlopeStdErr, 0.0)
+	assert.Less(t, fit.R2, 0.5)
+
+	_, err = num.FitLine([]num.Point{{X: 0, Y: 1}})
+	assert.Error(t, err)
+	_, err = num.FitLine([]num.Point{{X: 1, Y: 1}, {X: 1, Y: 2}})
+	assert.Error(t, err)
+}
+
+func TestFindRoot(t *testing.T) {
+	tbl := []struct {
+		f    func(x float64) (float64, error)
+		a, b float64
+		exp  float64
+	}{
+		{func(x float64) (float64, error) { return math.Cos(x) - x, nil }, 0, 1, 0.7390851332151607},
+		{func(x float64) (float64, error) { return x*x*x - 2, nil }, 2, 0, math.Cbrt(2)},
+		{func(x float64) (float64, error) { return math.Exp(x) - 1e6, nil }, 0, 100, math.Log(1e6)},
+		{func(x float64) (float64, error) { return x - 1, nil }, 1, 3, 1},
+		{func(x float64) (float64, error) { return math.Copysign(1, x-0.3), nil }, 0, 1, 0.3},
+	}
+	for i, tt := range tbl {
+		calls := 0
+		f := func(x float64) (float64, error) { calls++; return tt.f(x) }
+		x, err := num.FindRoot(f, tt.a, tt.b, 1e-12)
+		require.NoError(t, err, "case %d", i)
+		assert.InDelta(t, tt.exp, x, 1e-11, "case %d", i)
+		assert.Less(t, calls, 60, "case %d", i)
+	}
+
+	_, err := num.FindRoot(func(x float64) (float64, error) { return x*x + 1, nil }, -1, 1, 1e-12)
+	assert.Equal(t, num.ErrNotBracketed, errors.Cause(err))
+}
+
+func TestExplicit_Solve(t *testing.T) {
+	// y' = x^2 - 2y, y(0) = 1
+	exact := func(x float64) float64 { return x*x/2 - x/2 + 0.25 + 0.75*math.Exp(-2*x) }
+	fxy := func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }
+
+	tbl := []struct {
+		tableau string
+		name    string
+		order   float64
+	}{
+		{tableau: TableauEuler, name: "Euler's method", order: 1},
+		{tableau: TableauMidpoint, name: "Midpoint method", order: 2},
+		{tableau: TableauHeun, name: "Heun's method", order: 2},
+		{tableau: TableauRalston, name: "Ralston's method", order: 2},
+		{tableau: TableauRK3, name: "Kutta's third-order method", order: 3},
+		{tableau: TableauRK4, name: "Runge-Kutta's method", order: 4},
+		{tableau: TableauRK38, name: "Runge-Kutta's 3/8-rule method", order: 4},
+	}
+
+	for _, entry := range tbl {
+		s, err := NewExplicit(entry.tableau, fxy)
+		require.NoError(t, err, entry.tableau)
+
+		// the error at the end of the interval decreases as h^p
+		var errs []float64
+		for _, n := range []int{32, 64} {
+			line, err := s.Solve(num.CalculateStepSize(n, 0, 1), 0, 1, 1)
+			require.NoError(t, err, entry.tableau)
+			assert.Equal(t, entry.name, line.Name)
+			last := line.Points[len(line.Points)-1]
+			errs = append(errs, math.Abs(last.Y-exact(last.X)))
+		}
+		assert.InDelta(t, entry.order, math.Log2(errs[0]/errs[1]), 0.1, entry.tableau)
+	}
+
+	_, err := NewExplicit("unknown", fxy)
+	assert.Error(t, err)
+}
+
+func TestExplicit_SameAsHandWritten(t *testing.T) {
+	fxy := func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }
+
+	line, err := NewImprovedEuler(fxy).Solve(0.1, 0, 1, 1)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.820250, line.Points[1].Y, 0.000001)
+	assert.InDelta(t, 0.674755, line.Points[2].Y, 0.000001)
+
+	line, err = NewRungeKutta(fxy).Solve(0.1, 0, 1, 1)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.819051, line.Points[1].Y, 0.000001)
+	assert.InDelta(t, 0.672745, line.Points[2].Y, 0.000001)
+
+	line, err = NewEuler(fxy).Solve(0.1, 0, 1, 1)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.80000, line.Points[1].Y, 0.00001)
+	assert.InDelta(t, 0.64100, line.Points[2].Y, 0.00001)
+}
+
+func TestRegisterTableau(t *testing.T) {
+	err := RegisterTableau(TableauRK4, Tableau{A: [][]float64{{}}, B: []float64{1}, C: []float64{0}})
+	assert.Error(t, err, "built-in tableaux can't be overridden")
+
+	err = RegisterTableau("invalid", Tableau{A: [][]float64{{}, {1}}, B: []float64{0.5, 0.4}, C: []float64{0, 1}})
+	assert.Error(t, err, "weights are not summed up to 1")
+
+	err = RegisterTableau("implicit", Tableau{A: [][]float64{{1}}, B: []float64{1}, C: []float64{1}})
+	assert.Error(t, err, "implicit methods are not supported")
+
+	err = RegisterTableau("ssprk3", Tableau{
+		A: [][]float64{{}, {1}, {1.0 / 4.0, 1.0 / 4.0}},
+		B: []float64{1.0 / 6.0, 1.0 / 6.0, 2.0 / 3.0},
+		C: []float64{0, 1, 1.0 / 2.0},
+	})
+	require.NoError(t, err)
+
+	err = RegisterTableau("ssprk3", Tableau{A: [][]float64{{}}, B: []float64{1}, C: []float64{0}})
+	assert.Error(t, err, "registered tableaux can't be overridden")
+
+	tbl, ok := LookupTableau("ssprk3")
+	require.True(t, ok)
+	assert.Equal(t, "ssprk3", tbl.Name)
+	assert.Contains(t, Tableaux(), "ssprk3")
+	assert.Contains(t, Tableaux(), TableauRK4)
+
+	// changes of the returned tableau must not affect the registered one
+	tbl.B[0] = 100
+	tbl, _ = LookupTableau("ssprk3")
+	assert.Equal(t, 1.0/6.0, tbl.B[0])
+
+	s, err := NewExplicit("ssprk3", func(x, y float64) (float64, error) { return y, nil })
+	require.NoError(t, err)
+	line, err := s.Solve(0.01, 0, 1, 1)
+	require.NoError(t, err)
+	last := line.Points[len(line.Points)-1]
+	assert.InDelta(t, math.Exp(last.X), last.Y, 1e-5)
+}
+
+func TestExplicit_SolveSystem(t *testing.T) {
+	// harmonic oscillator y1' = y2, y2' = -y1, y(0) = (0, 1), the solution is (sin x, cos x)
+	sys := func(x float64, y num.Vector) (num.Vector, error) { return num.Vector{y[1], -y[0]}, nil }
+
+	tbl := []struct {
+		method    string
+		name      string
+		precision float64
+	}{
+		{method: MethodEuler, name: "Euler's method", precision: 0.01},
+		{method: MethodImprovedEuler, name: "Improved Euler's method", precision: 0.0001},
+		{method: MethodRK4, name: "Runge-Kutta's method", precision: 0.0000001},
+	}
+
+	for _, entry := range tbl {
+		s, err := NewSystem(entry.method, sys)
+		require.NoError(t, err)
+		line, err := s.SolveSystem(0.001, 0, num.Vector{0, 1}, 1)
+		require.NoError(t, err)
+		assert.Equal(t, entry.name, line.Name)
+		require.Greater(t, len(line.Points), 999, entry.name)
+
+		for i, p := range line.Points {
+			require.Equal(t, 2, len(p.Y), "method: %s, step: %d", entry.name, i)
+			assert.InDelta(t, math.Sin(p.X), p.Y[0], entry.precision, "method: %s, step: %d", entry.name, i)
+			assert.InDelta(t, math.Cos(p.X), p.Y[1], entry.precision, "method: %s, step: %d", entry.name, i)
+		}
+
+		lines := line.Components()
+		require.Equal(t, 2, len(lines))
+		assert.Equal(t, entry.name+", y1", lines[0].Name)
+		assert.Equal(t, entry.name+", y2", lines[1].Name)
+		assert.Equal(t, line.Points[1].Y[1], lines[1].Points[1].Y)
+	}
+
+	// the scalar equation is the system of one equation
+	line, err := (&Explicit{
+		Sys:     func(x float64, y num.Vector) (num.Vector, error) { return num.Vector{x*x - 2.0*y[0]}, nil },
+		Tableau: builtinTableaux[TableauRK4],
+	}).SolveSystem(0.1, 0, num.Vector{1}, 1)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.819051, line.Points[1].Y[0], 0.000001)
+
+	_, err = (&Explicit{
+		Sys:     func(x float64, y num.Vector) (num.Vector, error) { return num.Vector{1}, nil },
+		Tableau: builtinTableaux[TableauEuler],
+	}).SolveSystem(0.1, 0, num.Vector{1, 2}, 1)
+	assert.Error(t, err, "dimension mismatch")
+
+	_, err = NewEuler(func(x, y float64) (float64, error) { return 1, nil }).SolveSystem(0.1, 0, num.Vector{1}, 1)
+	assert.Error(t, err, "no system calculator")
+}
+
+func TestReduceOrder(t *testing.T) {
+	_, err := ReduceOrder(0, nil)
+	assert.Error(t, err)
+
+	// y'' = -y, y(0) = 0, y'(0) = 1, the solution is sin x
+	sys, err := ReduceOrder(2, func(x float64, y num.Vector) (float64, error) { return -y[0], nil })
+	require.NoError(t, err)
+
+	dy, err := sys(0, num.Vector{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, num.Vector{2, -1}, dy)
+
+	_, err = sys(0, num.Vector{1, 2, 3})
+	assert.Error(t, err)
+
+	line, err := (&Explicit{Sys: sys, Tableau: builtinTableaux[TableauRK4]}).SolveSystem(0.01, 0, num.Vector{0, 1}, 3)
+	require.NoError(t, err)
+	for i, p := range line.Points {
+		assert.InDelta(t, math.Sin(p.X), p.Y[0], 0.000001, "step: %d", i)
+		assert.InDelta(t, math.Cos(p.X), p.Y[1], 0.000001, "step: %d", i)
+	}
+}
+
+func TestImplicit_Solve(t *testing.T) {
+	// y' = x^2 - 2y, y(0) = 1
+	exact := func(x float64) float64 { return x*x/2 - x/2 + 0.25 + 0.75*math.Exp(-2*x) }
+	fxy := func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }
+	dfdy := func(x, y float64) (float64, error) { return -2, nil }
+
+	tbl := []struct {
+		solver Interface
+		name   string
+		order  float64
+	}{
+		{solver: &BackwardEuler{F: fxy}, name: "Backward Euler's method", order: 1},
+		{solver: &BackwardEuler{F: fxy, DFDY: dfdy}, name: "Backward Euler's method", order: 1},
+		{solver: &Trapezoidal{F: fxy}, name: "Trapezoidal rule", order: 2},
+		{solver: &Trapezoidal{F: fxy, DFDY: dfdy}, name: "Trapezoidal rule", order: 2},
+		{solver: &BDF2{F: fxy}, name: "BDF2 method", order: 2},
+		{solver: &BDF2{F: fxy, DFDY: dfdy}, name: "BDF2 method", order: 2},
+	}
+
+	for _, entry := range tbl {
+		var errs []float64
+		for _, n := range []int{64, 128} {
+			line, err := entry.solver.Solve(num.CalculateStepSize(n, 0, 1), 0, 1, 1)
+			require.NoError(t, err, entry.name)
+			assert.Equal(t, entry.name, line.Name)
+			assert.Equal(t, n+1, len(line.Points), entry.name)
+			last := line.Points[len(line.Points)-1]
+			errs = append(errs, math.Abs(last.Y-exact(last.X)))
+		}
+		assert.InDelta(t, entry.order, math.Log2(errs[0]/errs[1]), 0.1, entry.name)
+	}
+}
+
+func TestImplicit_Stiff(t *testing.T) {
+	// y' = -1000(y - cos x), y(0) = 0, the solution quickly approaches cos x
+	fxy := func(x, y float64) (float64, error) { return -1000 * (y - math.Cos(x)), nil }
+
+	line, err := NewEuler(fxy).Solve(0.01, 0, 0, 1)
+	require.NoError(t, err)
+	assert.Greater(t, math.Abs(line.Points[len(line.Points)-1].Y), 1e10, "explicit method must be unstable")
+
+	for _, s := range []Interface{&BackwardEuler{F: fxy}, &BDF2{F: fxy}} {
+		line, err := s.Solve(0.01, 0, 0, 1)
+		require.NoError(t, err)
+		for _, p := range line.Points[10:] {
+			assert.InDelta(t, math.Cos(p.X), p.Y, 0.01, "%s at x=%.2f", line.Name, p.X)
+		}
+	}
+
+	// the trapezoidal rule is A-stable, so it does not blow up, but it is not L-stable,
+	// so the initial transient is damped very slowly
+	line, err = (&Trapezoidal{F: fxy}).Solve(0.01, 0, 0, 1)
+	require.NoError(t, err)
+	for _, p := range line.Points {
+		assert.Less(t, math.Abs(p.Y), 2.0, "trapezoidal at x=%.2f", p.X)
+	}
+}
+
+func TestAdams_Solve(t *testing.T) {
+	// y' = x^2 - 2y, y(0) = 1
+	exact := func(x float64) float64 { return x*x/2 - x/2 + 0.25 + 0.75*math.Exp(-2*x) }
+	fxy := func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }
+
+	for order := 2; order <= 5; order++ {
+		tbl := []struct {
+			solver Interface
+			name   string
+		}{
+			{solver: &AdamsBashforth{F: fxy, Order: order}, name: "Adams-Bashforth's method"},
+			{solver: &AdamsMoulton{F: fxy, Order: order}, name: "Adams-Moulton's method"},
+			{solver: &AdamsBashforthMoulton{F: fxy, Order: order}, name: "Adams-Bashforth-Moulton's method"},
+		}
+
+		for _, entry := range tbl {
+			var errs []float64
+			for _, n := range []int{128, 256} {
+				line, err := entry.solver.Solve(num.CalculateStepSize(n, 0, 2), 0, 1, 2)
+				require.NoError(t, err, entry.name)
+				assert.Equal(t, fmt.Sprintf("%s (order %d)", entry.name, order), line.Name)
+				assert.Equal(t, n+1, len(line.Points), entry.name)
+				last := line.Points[len(line.Points)-1]
+				errs = append(errs, math.Abs(last.Y-exact(last.X)))
+			}
+			assert.InDelta(t, float64(order), math.Log2(errs[0]/errs[1]), 0.25, "%s, order %d", entry.name, order)
+		}
+	}
+
+	line, err := (&AdamsBashforth{F: fxy}).Solve(0.1, 0, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Adams-Bashforth's method (order 4)", line.Name)
+
+	// the first steps are made with Runge-Kutta's method
+	rk, err := NewRungeKutta(fxy).Solve(0.1, 0, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, rk.Points[:4], line.Points[:4])
+	assert.NotEqual(t, rk.Points[4], line.Points[4])
+
+	_, err = (&AdamsBashforth{F: fxy, Order: 6}).Solve(0.1, 0, 1, 1)
+	assert.Error(t, err)
+	_, err = (&AdamsMoulton{F: fxy, Order: 1}).Solve(0.1, 0, 1, 1)
+	assert.Error(t, err)
+	_, err = (&AdamsBashforthMoulton{F: fxy, Order: 7}).Solve(0.1, 0, 1, 1)
+	assert.Error(t, err)
+	assert.Equal(t, "Adams-Moulton's method (order 1)", (&AdamsMoulton{F: fxy, Order: 1}).Name())
+	assert.Equal(t, 1, (&AdamsMoulton{F: fxy, Order: 1}).Steps())
+}
+
+func TestRegistry(t *testing.T) {
+	fxy := func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }
+
+	methods := Methods()
+	for _, name := range []string{"euler", "improved-euler", "midpoint", "heun", "ralston", "rk3", "rk4", "rk38",
+		"dopri5", "backward-euler", "trapezoidal", "bdf2", "ab2", "ab5", "am3", "abm4", "taylor2", "taylor3"} {
+		assert.Contains(t, methods, name)
+
+		s, err := New(name, fxy)
+		require.NoError(t, err, name)
+		_, err = s.Solve(0.1, 0, 1, 1)
+		require.NoError(t, err, name)
+	}
+
+	solvers, err := NewSet(DefaultMethods, fxy)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(solvers))
+	assert.Equal(t, "Runge-Kutta's method", solvers[0].(*Explicit).Name())
+	assert.Equal(t, "Improved Euler's method", solvers[1].(*Explicit).Name())
+	assert.Equal(t, "Euler's method", solvers[2].(*Explicit).Name())
+
+	_, err = NewSet(nil, fxy)
+	assert.Error(t, err)
+	_, err = NewSet([]string{"rk4", "unknown"}, fxy)
+	assert.Error(t, err)
+
+	assert.Error(t, Register("rk4", func(f func(x, y float64) (float64, error)) Interface { return NewEuler(f) }))
+	assert.Error(t, Register("heun", func(f func(x, y float64) (float64, error)) Interface { return NewEuler(f) }))
+	assert.Error(t, Register("custom", nil))
+	require.NoError(t, Register("custom-ab3", func(f func(x, y float64) (float64, error)) Interface {
+		return &AdamsBashforth{F: f, Order: 3}
+	}))
+	assert.Error(t, RegisterTableau("custom-ab3", Tableau{A: [][]float64{{}}, B: []float64{1}, C: []float64{0}}))
+
+	s, err := New("custom-ab3", fxy)
+	require.NoError(t, err)
+	require.IsType(t, &AdamsBashforth{}, s)
+	assert.Equal(t, 3, s.(*AdamsBashforth).Order)
+	assert.Contains(t, Methods(), "custom-ab3")
+
+	sys := func(x float64, y num.Vector) (num.Vector, error) { return num.Vector{y[1], -y[0]}, nil }
+	systems, err := NewSystemSet([]string{"rk4", "heun", "improved-euler", "euler"}, sys)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(systems))
+	_, err = NewSystem("bdf2", sys)
+	assert.Error(t, err)
+	_, err = NewSystemSet(nil, sys)
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{"rk4", "heun", "euler"}, ParseMethods(" rk4,heun, ,euler"))
+	assert.Empty(t, ParseMethods(""))
+}
+
+func TestStepper_Step(t *testing.T) {
+	f := func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }
+
+	// steps from the own points of the solution reproduce the solution
+	for _, name := range Methods() {
+		if name == MethodDormandPrince {
+			continue
+		}
+		s, err := New(name, f)
+		require.NoError(t, err, name)
+		stepper, ok := s.(Stepper)
+		require.True(t, ok, "%s must implement Stepper", name)
+
+		line, err := s.Solve(0.125, 0, 1, 1)
+		require.NoError(t, err, name)
+		require.Equal(t, 9, len(line.Points), name)
+
+		for i := 1; i < len(line.Points); i++ {
+			from := i - stepper.Steps()
+			if from < 0 {
+				from = 0
+			}
+			y, err := stepper.Step(0.125, line.Points[from:i])
+			require.NoError(t, err, name)
+			assert.InDelta(t, line.Points[i].Y, y, 1e-12, "method %s, step %d", name, i)
+		}
+	}
+
+	// single step of the adaptive method is made with the 5th order formula
+	dp := &DormandPrince{F: func(x, y float64) (float64, error) { return y, nil }}
+	errs := make([]float64, 2)
+	for i, h := range []float64{0.1, 0.05} {
+		y, err := dp.Step(h, []num.Point{{X: 0, Y: 1}})
+		require.NoError(t, err)
+		errs[i] = math.Abs(y - math.Exp(h))
+	}
+	assert.InDelta(t, 6, math.Log2(errs[0]/errs[1]), 0.1)
+}
+
+func TestSolvers_SameGrid(t *testing.T) {
+	f := func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }
+	var solvers []Interface
+	for _, name := range Methods() {
+		if name == MethodDormandPrince {
+			continue // adaptive grid
+		}
+		s, err := New(name, f)
+		require.NoError(t, err, name)
+		solvers = append(solvers, s)
+	}
+	solvers = append(solvers, &Exact{
+		F: func(x, c float64) (float64, error) { return x*x/2 - x/2 + 0.25 + c*math.Exp(-2*x), nil },
+		C: func(x0, y0 float64) (float64, error) { return (y0 - x0*x0/2 + x0/2 - 0.25) * math.Exp(2*x0), nil },
+	})
+	sys, err := NewExplicitSystem(TableauRK4, func(x float64, y num.Vector) (num.Vector, error) {
+		return num.Vector{y[1], -y[0]}, nil
+	})
+	require.NoError(t, err)
+
+	for _, x0 := range []float64{-4, -1.3, 0, 0.1, 7.7} {
+		for _, xEnd := range []float64{x0 + 0.3, x0 + 1, x0 + 8, x0 + 10.1, x0 - 0.3, x0 - 2.9} {
+			for _, n := range []int{1, 2, 3, 7, 30, 99} {
+				h := num.CalculateStepSize(n, x0, xEnd)
+				exp := num.Grid(h, x0, xEnd)
+				for _, s := range solvers {
+					line, err := s.Solve(h, x0, 1, xEnd)
+					require.NoError(t, err, "%T x0=%g, xEnd=%g, n=%d", s, x0, xEnd, n)
+					require.Equal(t, exp, line.Xs(), "%s x0=%g, xEnd=%g, n=%d", line.Name, x0, xEnd, n)
+				}
+
+				line, err := sys.SolveSystem(h, x0, num.Vector{1, 0}, xEnd)
+				require.NoError(t, err)
+				require.Equal(t, n+1, len(line.Points))
+				for i, p := range line.Points {
+					require.Equal(t, exp[i], p.X, "system x0=%g, xEnd=%g, n=%d", x0, xEnd, n)
+				}
+			}
+		}
+	}
+
+	ref := &Reference{F: f}
+	line, err := ref.Solve(num.CalculateStepSize(30, -4, 4), -4, 1, 4)
+	require.NoError(t, err)
+	assert.Equal(t, num.Grid(num.CalculateStepSize(30, -4, 4), -4, 4), line.Xs())
+}
+
+func TestSolvers_Backward(t *testing.T) {
+	// y' = x^2 - 2y, y(0) = 1 is solved backwards from y(1)
+	exact := func(x float64) float64 { return x*x/2 - x/2 + 0.25 + 0.75*math.Exp(-2*x) }
+	f := func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }
+
+	for _, name := range Methods() {
+		s, err := New(name, f)
+		require.NoError(t, err, name)
+
+		tol := 0.05
+		switch name {
+		case MethodRK4:
+			tol = 1e-8
+		case MethodDormandPrince:
+			tol = 1e-5
+		}
+
+		line, err := s.Solve(-0.01, 1, exact(1), 0)
+		require.NoError(t, err, name)
+		require.Greater(t, len(line.Points), 1, name)
+		assert.Equal(t, 1.0, line.Points[0].X, name)
+		assert.Equal(t, 0.0, line.Points[len(line.Points)-1].X, name)
+		for i, p := range line.Points {
+			if i > 0 {
+				require.Less(t, p.X, line.Points[i-1].X, "%s, step %d", name, i)
+			}
+			assert.InDelta(t, exact(p.X), p.Y, tol, "%s, step %d", name, i)
+		}
+
+		// forward and backward with the same method returns near the initial value
+		fwd, err := s.Solve(0.01, 0, 1, 1)
+		require.NoError(t, err, name)
+		back, err := s.Solve(-0.01, 1, fwd.Points[len(fwd.Points)-1].Y, 0)
+		require.NoError(t, err, name)
+		assert.InDelta(t, 1, back.Points[len(back.Points)-1].Y, 2*tol, name)
+	}
+
+	// the trapezoidal rule is symmetric, so it returns exactly to the initial value
+	// for the linear equation up to the round-off
+	tr, err := New(MethodTrapezoidal, func(x, y float64) (float64, error) { return -2 * y, nil })
+	require.NoError(t, err)
+	fwd, err := tr.Solve(0.1, 0, 1, 1)
+	require.NoError(t, err)
+	back, err := tr.Solve(-0.1, 1, fwd.Points[len(fwd.Points)-1].Y, 0)
+	require.NoError(t, err)
+	assert.InDelta(t, 1, back.Points[len(back.Points)-1].Y, 1e-12)
+
+	e := &Exact{
+		F: func(x, c float64) (float64, error) { return x*x/2 - x/2 + 0.25 + c*math.Exp(-2*x), nil },
+		C: func(x0, y0 float64) (float64, error) { return (y0 - x0*x0/2 + x0/2 - 0.25) * math.Exp(2*x0), nil },
+	}
+	line, err := e.Solve(-0.25, 1, exact(1), 0)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(line.Points))
+	for _, p := range line.Points {
+		assert.InDelta(t, exact(p.X), p.Y, 1e-12, "x=%g", p.X)
+	}
+}
+
+func TestSolveEvents(t *testing.T) {
+	// y' = -y, y(0) = 1, the solution crosses 0.5 at ln 2
+	decay := func(x, y float64) (float64, error) { return -y, nil }
+	half := func(x, y float64) (float64, error) { return y - 0.5, nil }
+
+	for _, name := range Methods() {
+		s, err := New(name, decay)
+		require.NoError(t, err, name)
+		tol := 0.1
+		if name == MethodRK4 || name == MethodDormandPrince {
+			tol = 1e-5
+		}
+
+		line, events, err := SolveEvents(s, decay, []Event{{Name: "half", G: half}}, 0.2, 0, 1, 2)
+		require.NoError(t, err, name)
+		require.Equal(t, 11, len(line.Points), name)
+		assert.Equal(t, 2.0, line.Points[10].X, name)
+		assert.NotEmpty(t, line.Name, name)
+		require.Equal(t, 1, len(events), name)
+		assert.Equal(t, "half", events[0].Event, name)
+		assert.InDelta(t, math.Ln2, events[0].X, tol, name)
+		assert.InDelta(t, 0.5, events[0].Y, 1e-9, name)
+
+		// the integration is stopped at the root, so the solution ends there
+		line, events, err = SolveEvents(s, decay, []Event{{Name: "half", G: half, Terminal: true}}, 0.2, 0, 1, 2)
+		require.NoError(t, err, name)
+		require.Equal(t, 1, len(events), name)
+		assert.True(t, events[0].Terminal, name)
+		require.Equal(t, 5, len(line.Points), name)
+		assert.Equal(t, num.Point{X: events[0].X, Y: events[0].Y}, line.Points[4], name)
+
+		// the solution is the same as without events
+		full, err := s.Solve(0.2, 0, 1, 2)
+		require.NoError(t, err, name)
+		assert.Equal(t, full.Name, line.Name, name)
+		if name != MethodDormandPrince {
+			for i := 0; i < 4; i++ {
+				assert.InDelta(t, full.Points[i].Y, line.Points[i].Y, 1e-12, "%s, step %d", name, i)
+			}
+		}
+	}
+
+	rk4, err := New(MethodRK4, decay)
+	require.NoError(t, err)
+
+	// the failure of the event function inside the step is not taken for the root at the end of the step
+	gap := func(x, y float64) (float64, error) {
+		if x > 0.41 && x < 0.59 {
+			return 0, errors.New("gap")
+		}
+		return x - 0.5, nil
+	}
+	_, _, err = SolveEvents(rk4, decay, []Event{{Name: "gap", G: gap}}, 0.2, 0, 1, 2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gap")
+
+	// direction of the crossing
+	_, events, err := SolveEvents(rk4, decay, []Event{{Name: "up", G: half, Direction: 1}}, 0.2, 0, 1, 2)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	// several roots of several events are in the order of integration, the root at x0 is not counted
+	wave := func(x, y float64) (float64, error) { return math.Sin(math.Pi * x), nil }
+	_, events, err = SolveEvents(rk4, decay, []Event{
+		{Name: "wave", G: wave, Direction: -1},
+		{Name: "half", G: half},
+	}, 0.35, 0, 1, 3.5)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(events))
+	assert.Equal(t, "half", events[0].Event)
+	assert.Equal(t, "wave", events[1].Event)
+	assert.InDelta(t, 1, events[1].X, 1e-9)
+	assert.Equal(t, "wave", events[2].Event)
+	assert.InDelta(t, 3, events[2].X, 1e-9)
+
+	// backwards from y(2) = exp(-2), g grows along the integration
+	_, events, err = SolveEvents(rk4, decay, []Event{{Name: "up", G: half, Direction: 1}}, -0.2, 2, math.Exp(-2), 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(events))
+	assert.InDelta(t, math.Ln2, events[0].X, 1e-4)
+
+	// y' = y^2, y(0) = 1, the solution y = 1/(1-x) has the singularity at x = 1,
+	// the integration is stopped before it, when y reaches 10 at x = 0.9
+	blowUp := func(x, y float64) (float64, error) { return y * y, nil }
+	for _, name := range Methods() {
+		s, err := New(name, blowUp)
+		require.NoError(t, err, name)
+		line, events, err := SolveEvents(s, blowUp, []Event{{Name: "big", G: func(x, y float64) (float64, error) {
+			return y - 10, nil
+		}, Terminal: true}}, 0.01, 0, 1, 2)
+		require.NoError(t, err, name)
+		require.Equal(t, 1, len(events), name)
+		assert.InDelta(t, 0.9, events[0].X, 0.05, name)
+		last := line.Points[len(line.Points)-1]
+		assert.Less(t, last.X, 1.0, name)
+		assert.InDelta(t, 10, last.Y, 1e-6, name)
+	}
+
+	// the step goes over the singularity of f at x = 1, the event depends only on x
+	pole := func(x, y float64) (float64, error) { return 1 / (x - 1), nil }
+	line, events, err := SolveEvents(&Explicit{F: pole, Tableau: builtinTableaux[TableauRK4]}, pole,
+		[]Event{{Name: "x", G: func(x, y float64) (float64, error) { return x - 0.95, nil }, Terminal: true}},
+		0.1, 0, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(events))
+	assert.InDelta(t, 0.95, events[0].X, 1e-12)
+	assert.InDelta(t, math.Log(0.05), line.Points[len(line.Points)-1].Y, 0.1)
+}
+
+func TestTaylor_Solve(t *testing.T) {
+	// y' = x^2 - 2y, y(0) = 1
+	exact := func(x float64) float64 { return x*x/2 - x/2 + 0.25 + 0.75*math.Exp(-2*x) }
+	fxy := func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }
+	// y'' = 2x - 2y', y''' = 2 - 2y''
+	y2 := func(x, y float64) (float64, error) { return 2*x - 2*(x*x-2*y), nil }
+	y3 := func(x, y float64) (float64, error) { return 2 - 2*(2*x-2*(x*x-2*y)), nil }
+
+	tbl := []struct {
+		solver Interface
+		name   string
+		order  float64
+	}{
+		{solver: &Taylor{F: fxy}, name: "Taylor's method (order 2)", order: 2},
+		{solver: &Taylor{F: fxy, Derivs: []func(x, y float64) (float64, error){y2}}, name: "Taylor's method (order 2)", order: 2},
+		{solver: &Taylor{F: fxy, Order: 3}, name: "Taylor's method (order 3)", order: 3},
+		{solver: &Taylor{F: fxy, Order: 3, Derivs: []func(x, y float64) (float64, error){y2, y3}},
+			name: "Taylor's method (order 3)", order: 3},
+	}
+
+	for _, entry := range tbl {
+		var errs []float64
+		for _, n := range []int{32, 64} {
+			line, err := entry.solver.Solve(num.CalculateStepSize(n, 0, 1), 0, 1, 1)
+			require.NoError(t, err, entry.name)
+			assert.Equal(t, entry.name, line.Name)
+			assert.Equal(t, n+1, len(line.Points), entry.name)
+			last := line.Points[len(line.Points)-1]
+			errs = append(errs, math.Abs(last.Y-exact(last.X)))
+		}
+		assert.InDelta(t, entry.order, math.Log2(errs[0]/errs[1]), 0.1, entry.name)
+	}
+
+	_, err := (&Taylor{F: fxy, Order: 4}).Solve(0.1, 0, 1, 1)
+	assert.Error(t, err)
+}
+
+func TestDerivatives_Apply(t *testing.T) {
+	fxy := func(x, y float64) (float64, error) { return x*x - 2.0*y, nil }
+	dfdy := func(x, y float64) (float64, error) { return -2, nil }
+	own := func(x, y float64) (float64, error) { return 0, nil }
+	d := Derivatives{DFDY: dfdy, Total: []func(x, y float64) (float64, error){own}}
+
+	be, am, ta := &BackwardEuler{F: fxy}, &AdamsMoulton{F: fxy}, &Taylor{F: fxy}
+	tr := &Trapezoidal{F: fxy, DFDY: own}
+	for _, s := range []Interface{be, am, ta, tr, NewEuler(fxy)} {
+		d.Apply(s)
+	}
+	assert.NotNil(t, be.DFDY)
+	assert.NotNil(t, am.DFDY)
+	assert.Equal(t, 1, len(ta.Derivs))
+
+	// own derivatives are not overridden
+	v, err := tr.DFDY(0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, v)
+}
@@ -0,0 +1,196 @@
+package solver
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// Tableau describes the Butcher tableau of the explicit Runge-Kutta method:
+//
+//	c | A
+//	--+---
+//	  | b
+type Tableau struct {
+	Name string      // name of the method, used as the name of the solution line
+	A    [][]float64 // runge-kutta matrix, must be strictly lower triangular
+	B    []float64   // weights
+	C    []float64   // nodes
+}
+
+// Stages returns the number of stages of the method
+func (t Tableau) Stages() int {
+	return len(t.B)
+}
+
+// Validate checks that the tableau describes a consistent explicit method
+func (t Tableau) Validate() error {
+	const eps = 1e-9
+	s := t.Stages()
+	if s == 0 {
+		return errors.New("tableau must have at least one stage")
+	}
+	if len(t.C) != s || len(t.A) != s {
+		return errors.Errorf("tableau dimensions mismatch: len(A)=%d, len(b)=%d, len(c)=%d", len(t.A), s, len(t.C))
+	}
+
+	sumB := 0.0
+	for i := 0; i < s; i++ {
+		if len(t.A[i]) > i {
+			for j := i; j < len(t.A[i]); j++ {
+				if t.A[i][j] != 0 {
+					return errors.Errorf("A[%d][%d] is not zero, only explicit methods are supported", i, j)
+				}
+			}
+		}
+
+		// consistency condition: c_i = sum_j a_ij
+		sumA := 0.0
+		for j := 0; j < i && j < len(t.A[i]); j++ {
+			sumA += t.A[i][j]
+		}
+		if math.Abs(sumA-t.C[i]) > eps {
+			return errors.Errorf("c[%d]=%g is not equal to the sum of the row A[%d]=%g", i, t.C[i], i, sumA)
+		}
+		sumB += t.B[i]
+	}
+
+	if math.Abs(sumB-1) > eps {
+		return errors.Errorf("sum of weights must be equal to 1, got %g", sumB)
+	}
+	return nil
+}
+
+// copy returns the deep copy of the tableau, so the registered ones can't be
+// modified by the callers
+func (t Tableau) copy() Tableau {
+	res := Tableau{
+		Name: t.Name,
+		A:    make([][]float64, len(t.A)),
+		B:    append([]float64(nil), t.B...),
+		C:    append([]float64(nil), t.C...),
+	}
+	for i := range t.A {
+		res.A[i] = append([]float64(nil), t.A[i]...)
+	}
+	return res
+}
+
+// names of the built-in tableaux
+const (
+	TableauEuler         = "euler"
+	TableauMidpoint      = "midpoint"
+	TableauImprovedEuler = "improved-euler"
+	TableauHeun          = "heun"
+	TableauRalston       = "ralston"
+	TableauRK3           = "rk3"
+	TableauRK4           = "rk4"
+	TableauRK38          = "rk38"
+)
+
+var builtinTableaux = map[string]Tableau{
+	TableauEuler: {
+		Name: "Euler's method",
+		A:    [][]float64{{}},
+		B:    []float64{1},
+		C:    []float64{0},
+	},
+	TableauMidpoint: {
+		Name: "Midpoint method",
+		A:    [][]float64{{}, {1.0 / 2.0}},
+		B:    []float64{0, 1},
+		C:    []float64{0, 1.0 / 2.0},
+	},
+	TableauImprovedEuler: {
+		Name: "Improved Euler's method",
+		A:    [][]float64{{}, {1.0 / 2.0}},
+		B:    []float64{0, 1},
+		C:    []float64{0, 1.0 / 2.0},
+	},
+	TableauHeun: {
+		Name: "Heun's method",
+		A:    [][]float64{{}, {1}},
+		B:    []float64{1.0 / 2.0, 1.0 / 2.0},
+		C:    []float64{0, 1},
+	},
+	TableauRalston: {
+		Name: "Ralston's method",
+		A:    [][]float64{{}, {2.0 / 3.0}},
+		B:    []float64{1.0 / 4.0, 3.0 / 4.0},
+		C:    []float64{0, 2.0 / 3.0},
+	},
+	TableauRK3: {
+		Name: "Kutta's third-order method",
+		A:    [][]float64{{}, {1.0 / 2.0}, {-1, 2}},
+		B:    []float64{1.0 / 6.0, 2.0 / 3.0, 1.0 / 6.0},
+		C:    []float64{0, 1.0 / 2.0, 1},
+	},
+	TableauRK4: {
+		Name: "Runge-Kutta's method",
+		A:    [][]float64{{}, {1.0 / 2.0}, {0, 1.0 / 2.0}, {0, 0, 1}},
+		B:    []float64{1.0 / 6.0, 1.0 / 3.0, 1.0 / 3.0, 1.0 / 6.0},
+		C:    []float64{0, 1.0 / 2.0, 1.0 / 2.0, 1},
+	},
+	TableauRK38: {
+		Name: "Runge-Kutta's 3/8-rule method",
+		A:    [][]float64{{}, {1.0 / 3.0}, {-1.0 / 3.0, 1}, {1, -1, 1}},
+		B:    []float64{1.0 / 8.0, 3.0 / 8.0, 3.0 / 8.0, 1.0 / 8.0},
+		C:    []float64{0, 1.0 / 3.0, 2.0 / 3.0, 1},
+	},
+}
+
+// RegisterTableau registers the custom tableau with the given name, so it can be
+// found later with LookupTableau, neither built-in nor registered tableaux and methods
+// can be overridden
+func RegisterTableau(name string, t Tableau) error {
+	if name == "" {
+		return errors.New("tableau name is empty")
+	}
+	if err := t.Validate(); err != nil {
+		return errors.Wrapf(err, "invalid tableau %s", name)
+	}
+	if t.Name == "" {
+		t.Name = name
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	if err := checkNameFree(name); err != nil {
+		return err
+	}
+	registry.tableaux[name] = t.copy()
+	return nil
+}
+
+// LookupTableau returns the built-in or registered tableau by its name
+func LookupTableau(name string) (Tableau, bool) {
+	if t, ok := builtinTableaux[name]; ok {
+		return t.copy(), true
+	}
+
+	registry.RLock()
+	defer registry.RUnlock()
+	t, ok := registry.tableaux[name]
+	if !ok {
+		return Tableau{}, false
+	}
+	return t.copy(), true
+}
+
+// Tableaux returns the sorted list of names of all available tableaux
+func Tableaux() []string {
+	var res []string
+	for name := range builtinTableaux {
+		res = append(res, name)
+	}
+
+	registry.RLock()
+	for name := range registry.tableaux {
+		res = append(res, name)
+	}
+	registry.RUnlock()
+
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,138 @@
+package solver
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/Semior001/decompract/app/num"
+	log "github.com/go-pkgz/lgr"
+	"github.com/pkg/errors"
+)
+
+// Taylor is the Taylor series method for solving initial value problem for
+// differential equations, the higher derivatives of y are calculated along the
+// solution from the derivatives of f(x,y)
+type Taylor struct {
+	F      func(x, y float64) (float64, error)   // calculator for f(x,y) = y'
+	Derivs []func(x, y float64) (float64, error) // calculators for y'', y''', ..., approximated numerically if not set
+	Order  int                                   // order of the method, 2 or 3, 2 if not set
+}
+
+// Solve the initial value problem with the Taylor series method, which is
+// y_{i+1} = y_i + h y^(1)_i + h^2/2 y^(2)_i + ... + h^p/p! y^(p)_i
+func (t *Taylor) Solve(stepSize, x0, y0, xEnd float64) (num.Line, error) {
+	derivs, err := t.derivatives()
+	if err != nil {
+		return num.Line{}, err
+	}
+
+	y := y0
+
+	log.Printf("[DEBUG] starting solving the equation with Taylor's method of order %d "+
+		"with stepsz = %.4f, x0 = %.4f, y0 = %.4f, xend = %.4f", len(derivs), stepSize, x0, y0, xEnd)
+
+	xs := num.Grid(stepSize, x0, xEnd)
+	pts := make([]num.Point, 0, len(xs))
+	for i, x := range xs {
+		if i > 0 {
+			if y, err = taylorStep(derivs, stepSize, xs[i-1], y); err != nil {
+				return num.Line{}, errors.Wrapf(err, "failed to make a step at x=%.4f", xs[i-1])
+			}
+		}
+		pts = append(pts, num.Point{X: x, Y: y})
+	}
+
+	return num.Line{Name: t.Name(), Points: pts}, nil
+}
+
+// Name returns the name of the method with its order
+func (t *Taylor) Name() string {
+	order := t.Order
+	if order == 0 {
+		order = 2
+	}
+	return fmt.Sprintf("Taylor's method (order %d)", order)
+}
+
+// Steps returns the number of previous points, required by the method
+func (t *Taylor) Steps() int { return 1 }
+
+// Step makes a single step of the size h from the last of the given points
+func (t *Taylor) Step(h float64, prev []num.Point) (float64, error) {
+	derivs, err := t.derivatives()
+	if err != nil {
+		return 0, err
+	}
+	last := prev[len(prev)-1]
+	return taylorStep(derivs, h, last.X, last.Y)
+}
+
+// derivatives returns the calculators of the derivatives of y up to the order p, the missing
+// ones are approximated numerically by the previous ones
+func (t *Taylor) derivatives() ([]func(x, y float64) (float64, error), error) {
+	order := t.Order
+	if order == 0 {
+		order = 2
+	}
+	if order < 2 || order > 3 {
+		return nil, errors.Errorf("order %d of Taylor's method is not supported", order)
+	}
+
+	res := []func(x, y float64) (float64, error){t.F}
+	for i := 1; i < order; i++ {
+		if i-1 < len(t.Derivs) && t.Derivs[i-1] != nil {
+			res = append(res, t.Derivs[i-1])
+			continue
+		}
+		res = append(res, numTotalDerivative(res[i-1], t.F))
+	}
+	return res, nil
+}
+
+// taylorStep calculates the next y value by the truncated Taylor series
+func taylorStep(derivs []func(x, y float64) (float64, error), h, x, y float64) (float64, error) {
+	res, coef := y, 1.0
+	for i, d := range derivs {
+		v, err := d(x, y)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to calculate the derivative of order %d for x=%.4f y=%.4f", i+1, x, y)
+		}
+		coef *= h / float64(i+1)
+		res += coef * v
+	}
+	return res, nil
+}
+
+// numTotalDerivative approximates the total derivative of g(x,y) along the solution
+// of y' = f(x,y), i.e. Dg = ∂g/∂x + ∂g/∂y * f, with the central finite differences
+func numTotalDerivative(g, f func(x, y float64) (float64, error)) func(x, y float64) (float64, error) {
+	diff := func(g1 func(d float64) (float64, error), d float64) (float64, error) {
+		gp, err := g1(d)
+		if err != nil {
+			return 0, err
+		}
+		gm, err := g1(-d)
+		if err != nil {
+			return 0, err
+		}
+		return (gp - gm) / (2 * d), nil
+	}
+
+	return func(x, y float64) (float64, error) {
+		dx := math.Cbrt(2.2e-16) * math.Max(1, math.Abs(x))
+		gx, err := diff(func(d float64) (float64, error) { return g(x+d, y) }, dx)
+		if err != nil {
+			return 0, err
+		}
+		dy := math.Cbrt(2.2e-16) * math.Max(1, math.Abs(y))
+		gy, err := diff(func(d float64) (float64, error) { return g(x, y+d) }, dy)
+		if err != nil {
+			return 0, err
+		}
+		fv, err := f(x, y)
+		if err != nil {
+			return 0, err
+		}
+		return gx + gy*fv, nil
+	}
+}
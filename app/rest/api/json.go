@@ -0,0 +1,477 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	log "github.com/go-pkgz/lgr"
+	R "github.com/go-pkgz/rest"
+	"github.com/pkg/errors"
+
+	"github.com/Semior001/decompract/app/num"
+	"github.com/Semior001/decompract/app/num/expr"
+	"github.com/Semior001/decompract/app/num/graph"
+	"github.com/Semior001/decompract/app/num/service"
+	"github.com/Semior001/decompract/app/num/solver"
+	"github.com/Semior001/decompract/app/rest"
+)
+
+// maxBodySize is the max size of the request body in bytes, the problems are
+// described by a few expressions and numbers, so they are much smaller
+const maxBodySize = 64 << 10
+
+// problemReq describes the initial value problem in the JSON API,
+// if fxy is empty, the default equation is solved
+type problemReq struct {
+	service.ProblemSpec
+	Image imageReq `json:"image"` // format and size of the plot for /plot
+
+	X0s service.Sweep `json:"x0s"` // initial values of the family of solutions, x0 is used if empty
+	Y0s service.Sweep `json:"y0s"` // initial values of the family of solutions, y0 is used if empty
+}
+
+// imageReq describes the format and the size of the plot image with the axes and the styles
+// of the lines, the defaults are used for the zero values
+type imageReq struct {
+	Format string  `json:"format"` // png, svg, pdf or eps, png by default
+	Width  float64 `json:"width"`  // width in inches, 10 by default
+	Height float64 `json:"height"` // height in inches, equal to the width by default
+	DPI    int     `json:"dpi"`    // resolution of png, 96 by default
+
+	NoSolutions bool `json:"no_solutions"` // slope field is plotted without the solutions
+
+	graph.Options
+}
+
+// phaseReq describes the autonomous system of two equations for the phase portrait in the JSON API,
+// the system is set either by the right-hand sides, separated by ';', or by the second order equation
+type phaseReq struct {
+	Fxy      string       `json:"fxy"`      // right-hand sides of y1' and y2', e.g. "y1 - y1*y2; y1*y2 - y2"
+	Equation string       `json:"equation"` // second order equation, e.g. "y'' + 0.2*y' + sin(y) = 0"
+	Methods  []string     `json:"methods"`  // the trajectories are solved by the first one
+	X0       float64      `json:"x0"`
+	Points   []num.Vector `json:"points"` // initial points (y1, y2) of the trajectories
+	XEnd     float64      `json:"x_end"`
+	N        int          `json:"n"`
+	Image    imageReq     `json:"image"` // format and size of the plot for /phase/plot
+}
+
+// linesResp is a response with the calculated lines
+type linesResp struct {
+	Methods  []string   `json:"methods"`
+	Lines    []num.Line `json:"lines"`
+	Warnings []string   `json:"warnings,omitempty"` // failed checks of the exact solution
+
+	Events []service.MethodEvents `json:"events,omitempty"` // roots of the event functions on the solutions
+}
+
+// GET /api/v1/methods - list of available methods
+func (s *Rest) methodsCtrl(w http.ResponseWriter, r *http.Request) {
+	render.JSON(w, r, R.JSON{"methods": solver.Methods(), "default": s.Methods})
+}
+
+// POST /api/v1/solve - solve the equation with the given methods, the exact solution is the last line
+func (s *Rest) solveCtrl(w http.ResponseWriter, r *http.Request) {
+	req, ok := readProblem(w, r, false)
+	if !ok {
+		return
+	}
+
+	srv, methods, err := s.prepareService(req.Fxy, req.Yxc, req.C, req.Methods, req.Relative, req.Norm, req.Events)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to prepare solvers", rest.ErrBadRequest)
+		return
+	}
+
+	warnings, ok := checkExact(w, r, srv, req)
+	if !ok {
+		return
+	}
+
+	lines, events, err := srv.SolutionsEvents(num.CalculateStepSize(req.N, req.X0, req.XEnd), req.X0, req.Y0, req.XEnd)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusInternalServerError, err, "failed to solve", rest.ErrInternal)
+		return
+	}
+
+	render.JSON(w, r, linesResp{Methods: methods, Lines: lines, Warnings: warnings, Events: events})
+}
+
+// POST /api/v1/lte - calculate local truncation errors of the given methods
+func (s *Rest) lteCtrl(w http.ResponseWriter, r *http.Request) {
+	s.errorsCtrl(w, r, false, "failed to calculate lte", func(srv *service.Service, req problemReq) ([]num.Line, error) {
+		return srv.LocalErrors(num.CalculateStepSize(req.N, req.X0, req.XEnd), req.X0, req.Y0, req.XEnd)
+	})
+}
+
+// POST /api/v1/pointwise - calculate global errors of the given methods at each point
+func (s *Rest) pointwiseCtrl(w http.ResponseWriter, r *http.Request) {
+	s.errorsCtrl(w, r, false, "failed to calculate errors", func(srv *service.Service, req problemReq) ([]num.Line, error) {
+		return srv.PointwiseErrors(num.CalculateStepSize(req.N, req.X0, req.XEnd), req.X0, req.Y0, req.XEnd)
+	})
+}
+
+// POST /api/v1/gte - calculate norms of global errors of the given methods for each N from nmin to nmax
+func (s *Rest) gteCtrl(w http.ResponseWriter, r *http.Request) {
+	s.errorsCtrl(w, r, true, "failed to calculate gte", func(srv *service.Service, req problemReq) ([]num.Line, error) {
+		return srv.GlobalErrors(req.NMin, req.NMax, req.X0, req.Y0, req.XEnd)
+	})
+}
+
+// errorsCtrl responds with the errors of the given methods, calculated by calc, gte is set for the requests
+// with the range of the numbers of steps, failure describes the failed calculation in the response
+func (s *Rest) errorsCtrl(w http.ResponseWriter, r *http.Request, gte bool, failure string,
+	calc func(srv *service.Service, req problemReq) ([]num.Line, error)) {
+	req, ok := readProblem(w, r, gte)
+	if !ok {
+		return
+	}
+
+	srv, methods, err := s.prepareService(req.Fxy, req.Yxc, req.C, req.Methods, req.Relative, req.Norm, req.Events)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to prepare solvers", rest.ErrBadRequest)
+		return
+	}
+
+	warnings, ok := checkExact(w, r, srv, req)
+	if !ok {
+		return
+	}
+
+	lines, err := calc(srv, req)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusInternalServerError, err, failure, rest.ErrInternal)
+		return
+	}
+
+	render.JSON(w, r, linesResp{Methods: methods, Lines: lines, Warnings: warnings})
+}
+
+// POST /api/v1/family - solve the equation by the first of the given methods from each pair of the initial values
+// from x0s and y0s, responds with the family of solutions
+func (s *Rest) familyCtrl(w http.ResponseWriter, r *http.Request) {
+	req, ok := readProblem(w, r, false)
+	if !ok {
+		return
+	}
+
+	srv, _, err := s.prepareService(req.Fxy, req.Yxc, req.C, req.Methods, req.Relative, req.Norm, req.Events)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to prepare solvers", rest.ErrBadRequest)
+		return
+	}
+
+	x0s, y0s, ok := readFamily(w, r, req)
+	if !ok {
+		return
+	}
+
+	fam, err := srv.SolutionFamily(req.N, x0s, y0s, req.XEnd)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to solve family", rest.ErrBadRequest)
+		return
+	}
+
+	render.JSON(w, r, fam)
+}
+
+// readFamily returns the initial values of the family of solutions, responds with the error and returns false
+// if the sweeps are invalid
+func readFamily(w http.ResponseWriter, r *http.Request, req problemReq) (x0s, y0s []float64, ok bool) {
+	var err error
+	if x0s, err = req.X0s.Points(req.X0); err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, errors.Wrap(err, "invalid x0s"), "invalid family", rest.ErrBadRequest)
+		return nil, nil, false
+	}
+	if y0s, err = req.Y0s.Points(req.Y0); err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, errors.Wrap(err, "invalid y0s"), "invalid family", rest.ErrBadRequest)
+		return nil, nil, false
+	}
+	return x0s, y0s, true
+}
+
+// POST /api/v1/plot/{kind} - plot the solutions, lte, pointwise global errors, gte of the given methods, the slope
+// field or the family of solutions of the equation, responds with the image of the requested format and its media type
+func (s *Rest) plotCtrl(w http.ResponseWriter, r *http.Request) {
+	kind := chi.URLParam(r, "kind")
+	switch kind {
+	case "solutions", "lte", "pointwise", "gte", "slope_field", "family":
+	default:
+		rest.SendErrorJSON(w, r, http.StatusBadRequest,
+			errors.Errorf("unknown plot %q, available plots: solutions, lte, pointwise, gte, slope_field, family", kind),
+			"unknown plot", rest.ErrBadRequest)
+		return
+	}
+
+	req, ok := readProblem(w, r, kind == "gte")
+	if !ok {
+		return
+	}
+
+	pl, err := graph.NewPlotter(req.Image.Format, req.Image.Width, req.Image.Height, req.Image.DPI)
+	if err == nil {
+		pl.Options, err = req.Image.Options, req.Image.Validate()
+	}
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "invalid image", rest.ErrBadRequest)
+		return
+	}
+
+	srv, _, err := s.prepareService(req.Fxy, req.Yxc, req.C, req.Methods, req.Relative, req.Norm, req.Events)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to prepare solvers", rest.ErrBadRequest)
+		return
+	}
+	srv.Plotter = pl
+
+	var img []byte
+	var lines []num.Line
+	switch h := num.CalculateStepSize(req.N, req.X0, req.XEnd); kind {
+	case "solutions":
+		if lines, err = srv.Solutions(h, req.X0, req.Y0, req.XEnd); err == nil {
+			img, err = srv.PlotSolutions(lines)
+		}
+	case "lte":
+		if lines, err = srv.LocalErrors(h, req.X0, req.Y0, req.XEnd); err == nil {
+			img, err = srv.PlotLocalErrors(lines)
+		}
+	case "pointwise":
+		if lines, err = srv.PointwiseErrors(h, req.X0, req.Y0, req.XEnd); err == nil {
+			img, err = srv.PlotPointwiseErrors(lines)
+		}
+	case "gte":
+		img, err = srv.PlotGlobalErrors(req.NMin, req.NMax, req.X0, req.Y0, req.XEnd)
+	case "slope_field":
+		if lines, err = srv.Solutions(h, req.X0, req.Y0, req.XEnd); err == nil {
+			img, err = srv.PlotSlopeField(req.X0, req.Y0, req.XEnd, lines, !req.Image.NoSolutions)
+		}
+	case "family":
+		x0s, y0s, ok := readFamily(w, r, req)
+		if !ok {
+			return
+		}
+		img, err = srv.PlotSolutionFamily(req.N, x0s, y0s, req.XEnd)
+	}
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusInternalServerError, err, "failed to plot "+kind, rest.ErrInternal)
+		return
+	}
+
+	w.Header().Set("Content-Type", pl.MIME())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", kind+"."+pl.Ext()))
+	w.WriteHeader(http.StatusOK)
+	if _, err = w.Write(img); err != nil {
+		log.Printf("[WARN] can't write %s plot, %v", kind, err)
+	}
+}
+
+// POST /api/v1/phase - solve the trajectories of the autonomous system of two equations
+// from the given initial points and find its equilibria
+func (s *Rest) phaseCtrl(w http.ResponseWriter, r *http.Request) {
+	req, srv, ok := s.readPhase(w, r)
+	if !ok {
+		return
+	}
+
+	ph, err := srv.PhasePortrait(num.CalculateStepSize(req.N, req.X0, req.XEnd), req.X0, req.Points, req.XEnd)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to calculate phase portrait", rest.ErrBadRequest)
+		return
+	}
+
+	render.JSON(w, r, ph)
+}
+
+// POST /api/v1/phase/plot - plot the phase portrait of the autonomous system of two equations,
+// responds with the image of the requested format and its media type
+func (s *Rest) phasePlotCtrl(w http.ResponseWriter, r *http.Request) {
+	req, srv, ok := s.readPhase(w, r)
+	if !ok {
+		return
+	}
+
+	pl, err := graph.NewPlotter(req.Image.Format, req.Image.Width, req.Image.Height, req.Image.DPI)
+	if err == nil {
+		pl.Options, err = req.Image.Options, req.Image.Validate()
+	}
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "invalid image", rest.ErrBadRequest)
+		return
+	}
+	srv.Plotter = pl
+
+	ph, err := srv.PhasePortrait(num.CalculateStepSize(req.N, req.X0, req.XEnd), req.X0, req.Points, req.XEnd)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to calculate phase portrait", rest.ErrBadRequest)
+		return
+	}
+	img, err := srv.PlotPhase(req.X0, ph)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusInternalServerError, err, "failed to plot phase portrait", rest.ErrInternal)
+		return
+	}
+
+	w.Header().Set("Content-Type", pl.MIME())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", "phase."+pl.Ext()))
+	w.WriteHeader(http.StatusOK)
+	if _, err = w.Write(img); err != nil {
+		log.Printf("[WARN] can't write phase plot, %v", err)
+	}
+}
+
+// readPhase decodes the system of two equations from the request body and makes the service to solve it,
+// responds with the error and returns false if the system is invalid
+func (s *Rest) readPhase(w http.ResponseWriter, r *http.Request) (phaseReq, *service.Service, bool) {
+	var req phaseReq
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+	if err := render.DecodeJSON(r.Body, &req); err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to decode system", rest.ErrDecode)
+		return phaseReq{}, nil, false
+	}
+
+	err := service.ValidateGrid(req.X0, req.XEnd, req.N)
+	if err == nil && (req.Fxy == "") == (req.Equation == "") {
+		err = errors.New("either fxy or equation must be set")
+	}
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "invalid system", rest.ErrBadRequest)
+		return phaseReq{}, nil, false
+	}
+
+	var sys func(x float64, y num.Vector) (num.Vector, error)
+	if req.Equation != "" {
+		var order int
+		var f func(x float64, y num.Vector) (float64, error)
+		if order, f, err = expr.HigherOrder(req.Equation); err == nil && order != 2 {
+			err = errors.Errorf("phase portrait requires the equation of the second order, got %d", order)
+		}
+		if err == nil {
+			sys, err = solver.ReduceOrder(order, f)
+		}
+	} else {
+		exprs := expr.Split(req.Fxy)
+		if len(exprs) != 2 {
+			err = errors.Errorf("phase portrait requires the system of two equations, got %d", len(exprs))
+		}
+		if err == nil {
+			sys, err = expr.System(exprs)
+		}
+	}
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to parse system", rest.ErrBadRequest)
+		return phaseReq{}, nil, false
+	}
+
+	methods := req.Methods
+	if len(methods) == 0 {
+		methods = s.Methods
+	}
+	srv, err := service.NewSystem(sys, methods, s.Plotter)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to prepare solvers", rest.ErrBadRequest)
+		return phaseReq{}, nil, false
+	}
+	return req, srv, true
+}
+
+// readProblem decodes and validates the problem from the request body, responds with
+// the error and returns false if the problem is invalid, gte specifies whether
+// the range of N is required instead of N
+func readProblem(w http.ResponseWriter, r *http.Request, gte bool) (problemReq, bool) {
+	var req problemReq
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+	if err := render.DecodeJSON(r.Body, &req); err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to decode problem", rest.ErrDecode)
+		return problemReq{}, false
+	}
+
+	err := service.ValidateGrid(req.X0, req.XEnd, req.N)
+	if gte {
+		err = service.ValidateGrids(req.X0, req.XEnd, req.NMin, req.NMax)
+	}
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "invalid problem", rest.ErrBadRequest)
+		return problemReq{}, false
+	}
+
+	return req, true
+}
+
+// checkExact checks, that the exact solution satisfies the problem, responds with
+// the error and returns false if the check can't be made, returns the warnings otherwise
+func checkExact(w http.ResponseWriter, r *http.Request, srv *service.Service, req problemReq) ([]string, bool) {
+	c, err := srv.CheckExact(req.X0, req.Y0, req.XEnd)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusInternalServerError, err, "failed to check exact solution", rest.ErrInternal)
+		return nil, false
+	}
+	return c.Warnings(), true
+}
+
+// POST /api/v1/convergence - estimate orders of convergence of the given methods by the norms of global
+// errors for each N from nmin to nmax, responds with the errors and the estimated orders
+func (s *Rest) convergenceCtrl(w http.ResponseWriter, r *http.Request) {
+	req, ok := readProblem(w, r, true)
+	if !ok {
+		return
+	}
+
+	srv, methods, err := s.prepareService(req.Fxy, req.Yxc, req.C, req.Methods, req.Relative, req.Norm, req.Events)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to prepare solvers", rest.ErrBadRequest)
+		return
+	}
+
+	warnings, ok := checkExact(w, r, srv, req)
+	if !ok {
+		return
+	}
+
+	lines, err := srv.GlobalErrors(req.NMin, req.NMax, req.X0, req.Y0, req.XEnd)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusInternalServerError, err, "failed to calculate gte", rest.ErrInternal)
+		return
+	}
+
+	convs, err := srv.Convergence(lines, req.X0, req.Y0, req.XEnd)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusInternalServerError, err, "failed to estimate convergence", rest.ErrInternal)
+		return
+	}
+
+	render.JSON(w, r, R.JSON{
+		"methods":     methods,
+		"lines":       lines,
+		"convergence": convs,
+		"warnings":    warnings,
+	})
+}
+
+// POST /api/v1/metrics - calculate all norms of the global errors of the given methods on the grid of N steps
+func (s *Rest) metricsCtrl(w http.ResponseWriter, r *http.Request) {
+	req, ok := readProblem(w, r, false)
+	if !ok {
+		return
+	}
+
+	srv, methods, err := s.prepareService(req.Fxy, req.Yxc, req.C, req.Methods, req.Relative, req.Norm, req.Events)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusBadRequest, err, "failed to prepare solvers", rest.ErrBadRequest)
+		return
+	}
+
+	warnings, ok := checkExact(w, r, srv, req)
+	if !ok {
+		return
+	}
+
+	metrics, err := srv.ErrorMetrics(num.CalculateStepSize(req.N, req.X0, req.XEnd), req.X0, req.Y0, req.XEnd)
+	if err != nil {
+		rest.SendErrorJSON(w, r, http.StatusInternalServerError, err, "failed to calculate metrics", rest.ErrInternal)
+		return
+	}
+
+	render.JSON(w, r, R.JSON{"methods": methods, "relative": srv.Relative, "metrics": metrics, "warnings": warnings})
+}
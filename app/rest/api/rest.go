@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"html/template"
-	"math"
 	"net/http"
 	"strings"
 	"sync"
@@ -14,6 +13,8 @@ import (
 
 	"github.com/rakyll/statik/fs"
 
+	"github.com/Semior001/decompract/app/num/expr"
+	"github.com/Semior001/decompract/app/num/graph"
 	"github.com/Semior001/decompract/app/num/solver"
 
 	"github.com/Semior001/decompract/app/num/service"
@@ -32,8 +33,6 @@ import (
 	"github.com/go-chi/httprate"
 	log "github.com/go-pkgz/lgr"
 	R "github.com/go-pkgz/rest"
-
-	"github.com/Knetic/govaluate"
 )
 
 const plotHTMLTmpl = `<!DOCTYPE html>
@@ -47,33 +46,158 @@ const plotHTMLTmpl = `<!DOCTYPE html>
 <div style="text-align: center; font-family: Arial, sans-serif; font-size: 18px;">
     <h1 style="position: relative; color: #4fbbd6; margin-top: 0.2em;">DEComPract</h1>
     <h3 style="position: relative; color: #666666; margin-top: 0.2em;">Yelshat Duskaliyev, B19-04</h3>
+    {{if .System}}
+    <p>{{if .Equation}}{{.Equation}}, reduced to the first order system{{else}}F(x,y) = ({{.Fxy}}){{end}}</p>
+    <p>x<sub>0</sub> = {{printf "%.4f" .X0}}; y<sub>0</sub> = {{.Y0Sys}}; X = {{printf "%.4f" .XEnd}}; N = {{.N}}</p>
+    {{else}}
     <p>f(x,y) = {{.Fxy}}; y(x,c) = {{.Yxc}}; C(x<sub>0</sub>,y<sub>0</sub>) = {{.Cx0y0}}</p>
     <p>x<sub>0</sub> = {{printf "%.4f" .X0}}; y<sub>0</sub> = {{printf "%.4f" .Y0}}; X = {{printf "%.4f" .XEnd}}; N = {{.N}}; N<sub>min</sub> = {{.NMin}}; N<sub>max</sub> = {{.NMax}}</p>
+    {{end}}
+    <p>Methods: {{.Methods}}</p>
+    {{if not .System}}<p>Errors: {{if .Relative}}relative{{else}}absolute{{end}}; GTE norm: {{.Norm}}</p>{{end}}
     <a href="/">Enter another data</a>
+    {{range .Warnings}}
+    <p style="color: #d9534f;">Warning: {{.}}</p>
+    {{end}}
 </div>
 <table width="100%" style="align-content: center; font-family: Arial, sans-serif; font-size: 18px; position: relative; margin-top: 0.2em;">
     <tr>
-        <td><img width="100%" src="data:image/jpg;base64,{{.SolutionsImg}}" alt="solutions plot"></td>
-        <td><img width="100%" src="data:image/jpg;base64,{{.LTEImg}}" alt="lte plot"></td>
-        <td><img width="100%" src="data:image/jpg;base64,{{.GTEImg}}" alt="gte plot"></td>
+        <td>{{template "image" .SolutionsImg}}</td>
+        {{if not .System}}
+        <td>{{template "image" .LTEImg}}</td>
+        {{end}}
+    </tr>
+    {{if not .System}}
+    <tr>
+        <td>{{template "image" .PointwiseImg}}</td>
+        <td>{{template "image" .GTEImg}}</td>
+    </tr>
+    {{end}}
+    {{if .SlopeFieldImg.Src}}
+    <tr>
+        <td>{{template "image" .SlopeFieldImg}}</td>
+    </tr>
+    {{end}}
+    {{if .FamilyImg.Src}}
+    <tr>
+        <td>{{template "image" .FamilyImg}}</td>
+    </tr>
+    {{end}}
+    {{if .PhaseImg.Src}}
+    <tr>
+        <td>{{template "image" .PhaseImg}}</td>
+    </tr>
+    {{end}}
+</table>
+{{if .Equilibria}}
+<table style="margin: auto; font-family: Arial, sans-serif; font-size: 18px; text-align: left;">
+    <tr><th>Equilibrium y1</th><th>y2</th></tr>
+    {{range .Equilibria}}
+    <tr>
+        <td>{{printf "%.6g" .X}}</td>
+        <td>{{printf "%.6g" .Y}}</td>
     </tr>
+    {{end}}
 </table>
+{{end}}
+{{if .Metrics}}
+<table style="margin: auto; font-family: Arial, sans-serif; font-size: 18px; text-align: left;">
+    <tr><th>Method</th><th>Max</th><th>L1</th><th>L2</th><th>RMS</th><th>Endpoint</th></tr>
+    {{range .Metrics}}
+    <tr>
+        <td>{{.Name}}</td>
+        <td>{{printf "%.3e" .Max}}</td>
+        <td>{{printf "%.3e" .L1}}</td>
+        <td>{{printf "%.3e" .L2}}</td>
+        <td>{{printf "%.3e" .RMS}}</td>
+        <td>{{printf "%.3e" .End}}</td>
+    </tr>
+    {{end}}
+</table>
+{{end}}
+{{if .Events}}
+<table style="margin: auto; font-family: Arial, sans-serif; font-size: 18px; text-align: left;">
+    <tr><th>Method</th><th>Event</th><th>x</th><th>y</th></tr>
+    {{range $m := .Events}}{{range .Events}}
+    <tr>
+        <td>{{$m.Name}}</td>
+        <td>{{.Event}}{{if .Terminal}} (stopped){{end}}</td>
+        <td>{{printf "%.6g" .X}}</td>
+        <td>{{printf "%.6g" .Y}}</td>
+    </tr>
+    {{end}}{{end}}
+</table>
+{{end}}
+{{if .Convergence}}
+<table style="margin: auto; font-family: Arial, sans-serif; font-size: 18px; text-align: left;">
+    <tr><th>Method</th><th>Order</th><th>Std. error</th><th>R<sup>2</sup></th><th>Note</th></tr>
+    {{range .Convergence}}
+    <tr>
+        <td>{{.Name}}</td>
+        {{if .Adaptive}}<td>-</td><td>-</td><td>-</td>{{else}}
+        <td>{{printf "%.3f" .Order}}</td>
+        <td>{{printf "%.3f" .StdErr}}</td>
+        <td>{{printf "%.4f" .R2}}</td>{{end}}
+        <td>{{.Note}}</td>
+    </tr>
+    {{end}}
+</table>
+{{end}}
 </body>
-</html>`
+</html>
+{{define "image"}}
+{{if .Preview}}<img width="100%" src="{{.Src}}" alt="{{.Alt}}">{{else}}<p>{{.Alt}} can't be shown in the browser</p>{{end}}
+<p style="text-align: center; font-family: Arial, sans-serif; font-size: 14px;"><a href="{{.Src}}" download="{{.File}}">Download {{.File}}</a></p>
+{{end}}`
 
 type plotTmplData struct {
-	X0           float64
-	Y0           float64
-	XEnd         float64
-	N            int
-	NMin         int
-	NMax         int
-	SolutionsImg string
-	LTEImg       string
-	GTEImg       string
-	Fxy          string
-	Yxc          string
-	Cx0y0        string
+	System        bool
+	X0            float64
+	Y0            float64
+	Y0Sys         string
+	Equation      string
+	Methods       string
+	XEnd          float64
+	N             int
+	NMin          int
+	NMax          int
+	SolutionsImg  plotImage
+	LTEImg        plotImage
+	PointwiseImg  plotImage
+	GTEImg        plotImage
+	SlopeFieldImg plotImage
+	FamilyImg     plotImage
+	PhaseImg      plotImage
+	Equilibria    []num.Point
+	Convergence   []service.Convergence
+	Metrics       []service.Metrics
+	Events        []service.MethodEvents
+	Relative      bool
+	Norm          service.Norm
+	Warnings      []string
+	Fxy           string
+	Yxc           string
+	Cx0y0         string
+}
+
+// plotImage is the encoded plot on the result page with the link to download it
+type plotImage struct {
+	Src     template.URL // data URI of the image
+	File    string       // name of the downloaded file
+	Alt     string
+	Preview bool // browsers show the images of the format in the img tag, e.g. pdf and eps are only downloaded
+}
+
+// newPlotImage makes the data URI of the plot, encoded by the plotter, name is the name of the file without extension
+func newPlotImage(pl graph.Plotter, name, alt string, b []byte) plotImage {
+	// the image is encoded here, so the URI is safe
+	src := template.URL("data:" + pl.MIME() + ";base64," + base64.StdEncoding.EncodeToString(b)) //nolint:gosec
+	return plotImage{
+		Src:     src,
+		File:    name + "." + pl.Ext(),
+		Alt:     alt,
+		Preview: pl.Format == "" || pl.Format == graph.PNG || pl.Format == graph.SVG,
+	}
 }
 
 // Rest defines a simple web server for routing to calendar REST api methods
@@ -81,7 +205,9 @@ type Rest struct {
 	Version string
 	WebRoot string
 
-	NumService *service.Service
+	Plotter graph.Plotter
+	Default service.Problem // problem to solve, if the request doesn't specify the equation
+	Methods []string        // methods to run, if the request doesn't specify them
 
 	httpServer *http.Server
 	lock       sync.Mutex
@@ -127,6 +253,20 @@ func (s *Rest) routes() chi.Router {
 		r.Use(middleware.Timeout(5 * time.Second))
 	})
 
+	r.Route("/api/v1", func(rapi chi.Router) {
+		rapi.Get("/methods", s.methodsCtrl)
+		rapi.Post("/solve", s.solveCtrl)
+		rapi.Post("/lte", s.lteCtrl)
+		rapi.Post("/pointwise", s.pointwiseCtrl)
+		rapi.Post("/gte", s.gteCtrl)
+		rapi.Post("/convergence", s.convergenceCtrl)
+		rapi.Post("/metrics", s.metricsCtrl)
+		rapi.Post("/plot/{kind}", s.plotCtrl)
+		rapi.Post("/family", s.familyCtrl)
+		rapi.Post("/phase", s.phaseCtrl)
+		rapi.Post("/phase/plot", s.phasePlotCtrl)
+	})
+
 	addFileServer(r, "/", http.Dir(s.WebRoot))
 	r.Post("/", s.plotGraphsCtrl)
 
@@ -167,48 +307,138 @@ func addFileServer(r chi.Router, path string, root http.FileSystem) {
 // POST / - plot graphs according to the given parameters
 func (s *Rest) plotGraphsCtrl(w http.ResponseWriter, r *http.Request) {
 	// reading form
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
 	req, err := readVals(r)
 	if err != nil {
 		rest.SendErrorHTML(w, r, http.StatusForbidden, err, "failed to read request values")
 		return
 	}
+	if err = req.validate(); err != nil {
+		rest.SendErrorHTML(w, r, http.StatusBadRequest, err, "invalid request values")
+		return
+	}
 
-	// if functions are specified, prepare them
-	if req.fxy != "" && req.yxc != "" && req.c != "" {
-		funcs, err := prepareFuncs(req.fxy, req.yxc, req.c)
+	// higher order equation is reduced to the system of first order equations
+	if req.equation != "" {
+		s.plotHigherOrder(w, r, req)
+		return
+	}
+
+	// several right-hand sides describe the system of equations
+	if len(req.Y0Sys) > 1 {
+		exprs := expr.Split(req.fxy)
+		if len(exprs) != len(req.Y0Sys) {
+			rest.SendErrorHTML(w, r, http.StatusBadRequest,
+				errors.Errorf("%d equations, but %d initial values", len(exprs), len(req.Y0Sys)),
+				"failed to read request values")
+			return
+		}
+
+		sys, err := expr.System(exprs)
 		if err != nil {
 			rest.SendErrorHTML(w, r, http.StatusBadRequest, err, "failed to parse functions")
 			return
 		}
 
-		// initializing services
-		s.NumService = &service.Service{
-			Plotter: s.NumService.Plotter,
-			Solvers: []solver.Interface{
-				&solver.RungeKutta{F: funcs.fxy},
-				&solver.ImprovedEuler{F: funcs.fxy},
-				&solver.Euler{F: funcs.fxy},
-			},
-			ExactSolver: &solver.Exact{F: funcs.yxc, C: funcs.cx0y0},
-		}
+		s.plotSystem(w, r, req, sys, plotTmplData{Fxy: strings.Join(exprs, "; ")})
+		return
+	}
+
+	numService, methods, err := s.prepareService(req.fxy, req.yxc, req.c, req.methods, req.relative, req.norm, req.events)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusBadRequest, err, "failed to prepare solvers")
+		return
+	}
+	numService.Plotter = req.plotter
+
+	consistency, err := numService.CheckExact(req.X0, req.Y0, req.XEnd)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to check exact solution")
+		return
+	}
+
+	// solving once, the errors, metrics and events are derived from the solutions
+	sols, events, err := numService.SolutionsEvents(num.CalculateStepSize(req.N, req.X0, req.XEnd), req.X0, req.Y0, req.XEnd)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to solve")
+		return
+	}
+	ltes, err := numService.LocalErrorsOf(req.X0, req.Y0, sols)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to calculate lte")
+		return
+	}
+	pointwise, err := numService.PointwiseErrorsOf(req.X0, req.Y0, sols)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to calculate global errors")
+		return
 	}
 
 	// encoding solutions plot
-	bSols, err := s.NumService.PlotSolutions(num.CalculateStepSize(req.N, req.X0, req.XEnd), req.X0, req.Y0, req.XEnd)
+	bSols, err := numService.PlotSolutions(sols)
 	if err != nil {
 		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to plot solutions")
 		return
 	}
 
 	// encoding lte plot
-	bLTEs, err := s.NumService.PlotLocalErrors(num.CalculateStepSize(req.N, req.X0, req.XEnd), req.X0, req.Y0, req.XEnd)
+	bLTEs, err := numService.PlotLocalErrors(ltes)
 	if err != nil {
 		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to plot lte")
 		return
 	}
 
-	// encoding gte plot
-	bGTEs, err := s.NumService.PlotGlobalErrors(req.NMin, req.NMax, req.X0, req.Y0, req.XEnd)
+	// encoding global errors plot
+	bPointwise, err := numService.PlotPointwiseErrors(pointwise)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to plot global errors")
+		return
+	}
+
+	// encoding slope field plot, if it is requested
+	var field plotImage
+	if req.slopeField != "" {
+		bField, err := numService.PlotSlopeField(req.X0, req.Y0, req.XEnd, sols, req.slopeField == "solutions")
+		if err != nil {
+			rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to plot slope field")
+			return
+		}
+		field = newPlotImage(req.plotter, "slope_field", "slope field plot", bField)
+	}
+
+	// encoding plot of the family of solutions, if any of the initial values is swept
+	var family plotImage
+	if !req.familyX0.Empty() || !req.familyY0.Empty() {
+		x0s, err := req.familyX0.Points(req.X0)
+		if err != nil {
+			rest.SendErrorHTML(w, r, http.StatusBadRequest, errors.Wrap(err, "can't read x0 of family"), "failed to read request values")
+			return
+		}
+		y0s, err := req.familyY0.Points(req.Y0)
+		if err != nil {
+			rest.SendErrorHTML(w, r, http.StatusBadRequest, errors.Wrap(err, "can't read y0 of family"), "failed to read request values")
+			return
+		}
+		bFamily, err := numService.PlotSolutionFamily(req.N, x0s, y0s, req.XEnd)
+		if err != nil {
+			rest.SendErrorHTML(w, r, http.StatusBadRequest, err, "failed to plot family of solutions")
+			return
+		}
+		family = newPlotImage(req.plotter, "family", "family of solutions plot", bFamily)
+	}
+
+	// encoding gte plot with the estimated orders of convergence
+	gte, err := numService.GlobalErrors(req.NMin, req.NMax, req.X0, req.Y0, req.XEnd)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to calculate gte")
+		return
+	}
+	convs, err := numService.Convergence(gte, req.X0, req.Y0, req.XEnd)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to estimate convergence")
+		return
+	}
+	bGTEs, err := numService.PlotConvergence(gte, convs)
 	if err != nil {
 		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to plot gte")
 		return
@@ -218,18 +448,28 @@ func (s *Rest) plotGraphsCtrl(w http.ResponseWriter, r *http.Request) {
 	buf := &bytes.Buffer{}
 	tmpl := template.Must(template.New("plot").Parse(plotHTMLTmpl))
 	err = tmpl.Execute(buf, plotTmplData{
-		X0:           req.X0,
-		Y0:           req.Y0,
-		XEnd:         req.XEnd,
-		N:            req.N,
-		NMin:         req.NMin,
-		NMax:         req.NMax,
-		SolutionsImg: base64.StdEncoding.EncodeToString(bSols),
-		LTEImg:       base64.StdEncoding.EncodeToString(bLTEs),
-		GTEImg:       base64.StdEncoding.EncodeToString(bGTEs),
-		Fxy:          req.fxy,
-		Yxc:          req.yxc,
-		Cx0y0:        req.c,
+		X0:            req.X0,
+		Y0:            req.Y0,
+		XEnd:          req.XEnd,
+		N:             req.N,
+		NMin:          req.NMin,
+		NMax:          req.NMax,
+		SolutionsImg:  newPlotImage(req.plotter, "solutions", "solutions plot", bSols),
+		LTEImg:        newPlotImage(req.plotter, "lte", "lte plot", bLTEs),
+		PointwiseImg:  newPlotImage(req.plotter, "pointwise", "global error plot", bPointwise),
+		GTEImg:        newPlotImage(req.plotter, "gte", "gte plot", bGTEs),
+		SlopeFieldImg: field,
+		FamilyImg:     family,
+		Convergence:   convs,
+		Metrics:       service.MetricsOf(pointwise),
+		Events:        events,
+		Relative:      numService.Relative,
+		Norm:          numService.Norm,
+		Warnings:      consistency.Warnings(),
+		Fxy:           req.fxy,
+		Yxc:           req.yxc,
+		Cx0y0:         req.c,
+		Methods:       strings.Join(methods, ", "),
 	})
 	if err != nil {
 		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "can't execute template")
@@ -240,16 +480,164 @@ func (s *Rest) plotGraphsCtrl(w http.ResponseWriter, r *http.Request) {
 	render.HTML(w, r, buf.String())
 }
 
+// prepareService makes the service for the current request with the solvers of the given methods for
+// the equation from the given expressions, if f(x,y) is empty, the default problem is solved, and the exact
+// solution must be empty too, if the exact solution is empty, the numerical reference one is used, if methods
+// are not specified, the default ones are used, relative and norm set up the measuring of errors, the roots
+// of the events are located on the solutions, returns the service and the used methods
+func (s *Rest) prepareService(fxyStr, yxcStr, cStr string, methods []string,
+	relative bool, norm string, events []service.EventSpec) (*service.Service, []string, error) {
+	if len(methods) == 0 {
+		methods = s.Methods
+	}
+
+	if fxyStr == "" && (yxcStr != "" || cStr != "") {
+		return nil, nil, errors.New("exact solution y(x,c) and c(x0,y0) require f(x,y)")
+	}
+
+	problem := s.Default
+
+	// if functions are specified, prepare them
+	if fxyStr != "" {
+		var err error
+		if problem, err = service.ParseProblem(fxyStr, yxcStr, cStr); err != nil {
+			return nil, nil, errors.Wrap(err, "failed to parse functions")
+		}
+	}
+
+	srv, err := service.New(problem, methods, s.Plotter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if srv.Norm, err = service.ParseNorm(norm); err != nil {
+		return nil, nil, err
+	}
+	srv.Relative = relative
+	if srv.Events, err = service.ParseEvents(events); err != nil {
+		return nil, nil, err
+	}
+	return srv, methods, nil
+}
+
+// plotHigherOrder plots the solution of the n-th order equation and its derivatives up to
+// the (n-1)-th order, y0 must contain n initial values y(x0), y'(x0), ..., y^(n-1)(x0)
+func (s *Rest) plotHigherOrder(w http.ResponseWriter, r *http.Request, req solveRequest) {
+	order, f, err := expr.HigherOrder(req.equation)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusBadRequest, err, "failed to parse equation")
+		return
+	}
+
+	if order != len(req.Y0Sys) {
+		rest.SendErrorHTML(w, r, http.StatusBadRequest,
+			errors.Errorf("equation of order %d requires %d initial values, got %d", order, order, len(req.Y0Sys)),
+			"failed to read request values")
+		return
+	}
+
+	sys, err := solver.ReduceOrder(order, f)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusBadRequest, err, "failed to reduce equation")
+		return
+	}
+
+	s.plotSystem(w, r, req, sys, plotTmplData{Equation: req.equation})
+}
+
+// plotSystem plots the solutions of the system of equations, as there is no exact
+// solution for systems, only solutions are plotted, data contains the description
+// of the equations to render
+func (s *Rest) plotSystem(w http.ResponseWriter, r *http.Request, req solveRequest,
+	sys func(x float64, y num.Vector) (num.Vector, error), data plotTmplData) {
+	methods := req.methods
+	if len(methods) == 0 {
+		methods = s.Methods
+	}
+
+	srv, err := service.NewSystem(sys, methods, req.plotter)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusBadRequest, err, "failed to prepare solvers")
+		return
+	}
+
+	bSols, err := srv.PlotSystemSolutions(num.CalculateStepSize(req.N, req.X0, req.XEnd), req.X0, req.Y0Sys, req.XEnd)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to plot solutions")
+		return
+	}
+
+	// encoding phase portrait from y0 and the other initial points, if it is requested
+	if req.phase {
+		if len(req.Y0Sys) != 2 {
+			rest.SendErrorHTML(w, r, http.StatusBadRequest,
+				errors.Errorf("phase portrait requires the system of two equations, got %d", len(req.Y0Sys)),
+				"failed to read request values")
+			return
+		}
+		ph, err := srv.PhasePortrait(num.CalculateStepSize(req.N, req.X0, req.XEnd), req.X0,
+			append([]num.Vector{req.Y0Sys}, req.phaseY0...), req.XEnd)
+		if err != nil {
+			rest.SendErrorHTML(w, r, http.StatusBadRequest, err, "failed to calculate phase portrait")
+			return
+		}
+		bPhase, err := srv.PlotPhase(req.X0, ph)
+		if err != nil {
+			rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "failed to plot phase portrait")
+			return
+		}
+		data.PhaseImg = newPlotImage(req.plotter, "phase", "phase portrait", bPhase)
+		data.Equilibria = ph.Equilibria
+	}
+
+	data.System = true
+	data.X0, data.Y0Sys, data.XEnd, data.N = req.X0, fmt.Sprintf("%v", req.Y0Sys), req.XEnd, req.N
+	data.SolutionsImg = newPlotImage(req.plotter, "solutions", "solutions plot", bSols)
+	data.Methods = strings.Join(methods, ", ")
+
+	buf := &bytes.Buffer{}
+	tmpl := template.Must(template.New("plot").Parse(plotHTMLTmpl))
+	err = tmpl.Execute(buf, data)
+	if err != nil {
+		rest.SendErrorHTML(w, r, http.StatusInternalServerError, err, "can't execute template")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.HTML(w, r, buf.String())
+}
+
 type solveRequest struct {
-	X0   float64
-	Y0   float64
-	XEnd float64
-	N    int
-	NMin int
-	NMax int
-	fxy  string
-	yxc  string
-	c    string
+	X0       float64
+	Y0       float64
+	Y0Sys    num.Vector // initial values for the system of equations, separated by ';' in the form
+	XEnd     float64
+	N        int
+	NMin     int
+	NMax     int
+	fxy      string
+	yxc      string
+	equation string   // n-th order equation, e.g. y'' + y = 0
+	methods  []string // names of the methods to run, e.g. rk4,heun,euler
+	c        string
+	relative bool                // calculate relative errors instead of absolute ones
+	norm     string              // norm of the global errors for GTE, max norm if empty
+	events   []service.EventSpec // event functions, separated by ';' in the form
+	plotter  graph.Plotter       // format and size of the plots
+	// slope field of the equation: "solutions" - overlaid by the solutions, "alone" - without them, not plotted if empty
+	slopeField string
+	familyX0   service.Sweep // initial values of the family of solutions, the family is not plotted if both are empty
+	familyY0   service.Sweep
+	phase      bool         // plot the phase portrait of the system of two equations
+	phaseY0    []num.Vector // initial points of the other trajectories of the phase portrait, besides y0
+}
+
+// validate checks the interval and the numbers of steps, as readProblem does for the API requests
+func (req solveRequest) validate() error {
+	if err := service.ValidateGrid(req.X0, req.XEnd, req.N); err != nil {
+		return err
+	}
+	return service.ValidateGrids(req.X0, req.XEnd, req.NMin, req.NMax)
 }
 
 func readVals(r *http.Request) (req solveRequest, err error) {
@@ -268,9 +656,18 @@ func readVals(r *http.Request) (req solveRequest, err error) {
 	if err := json.Unmarshal([]byte(r.Form["x0"][0]), &x0); err != nil {
 		return solveRequest{}, errors.Wrap(err, "can't read x0")
 	}
-	if err := json.Unmarshal([]byte(r.Form["y0"][0]), &y0); err != nil {
-		return solveRequest{}, errors.Wrap(err, "can't read y0")
+	var y0Sys num.Vector
+	for i, v := range expr.Split(r.Form["y0"][0]) {
+		var y float64
+		if err := json.Unmarshal([]byte(v), &y); err != nil {
+			return solveRequest{}, errors.Wrapf(err, "can't read y0[%d]", i)
+		}
+		y0Sys = append(y0Sys, y)
+	}
+	if len(y0Sys) == 0 {
+		return solveRequest{}, errors.New("y0 is empty")
 	}
+	y0 = y0Sys[0]
 	if err := json.Unmarshal([]byte(r.Form["x_end"][0]), &xEnd); err != nil {
 		return solveRequest{}, errors.Wrap(err, "can't read xEnd")
 	}
@@ -283,139 +680,118 @@ func readVals(r *http.Request) (req solveRequest, err error) {
 	if err := json.Unmarshal([]byte(r.Form["nmax"][0]), &nmax); err != nil {
 		return solveRequest{}, errors.Wrap(err, "can't read nmax")
 	}
-
-	return solveRequest{
-		X0:   x0,
-		Y0:   y0,
-		XEnd: xEnd,
-		N:    n,
-		NMax: nmax,
-		NMin: nmin,
-		fxy:  r.Form["fxy"][0],
-		yxc:  r.Form["yxc"][0],
-		c:    r.Form["c"][0],
-	}, nil
-}
-
-type parsedFuncs struct {
-	fxy   func(x, y float64) (float64, error)
-	yxc   func(x, c float64) (float64, error)
-	cx0y0 func(x0, y0 float64) (float64, error)
-}
-
-// prepareFuncs parses the string expressions and prepares the functions for the future evaluation
-func prepareFuncs(fxyStr, yxcStr, cStr string) (parsedFuncs, error) {
-	funcs := map[string]govaluate.ExpressionFunction{
-		"exp": govaluate.ExpressionFunction(func(args ...interface{}) (interface{}, error) {
-			if len(args) != 1 {
-				return nil, errors.New("exponent takes only 1 argument")
-			}
-			p, ok := args[0].(float64)
-			if !ok {
-				return nil, errors.New("argument is not of type float64")
-			}
-			return math.Exp(p), nil
-		}),
-		"ln": govaluate.ExpressionFunction(func(args ...interface{}) (interface{}, error) {
-			if len(args) != 1 {
-				return nil, errors.New("ln takes only 1 argument")
-			}
-			p, ok := args[0].(float64)
-			if !ok {
-				return nil, errors.New("argument is not of type float64")
-			}
-			return math.Log(p), nil
-		}),
-		"tan": govaluate.ExpressionFunction(func(args ...interface{}) (interface{}, error) {
-			if len(args) != 1 {
-				return nil, errors.New("tan takes only 1 argument")
-			}
-			p, ok := args[0].(float64)
-			if !ok {
-				return nil, errors.New("argument is not of type float64")
-			}
-			return math.Tan(p), nil
-		}),
-		"cos": govaluate.ExpressionFunction(func(args ...interface{}) (interface{}, error) {
-			if len(args) != 1 {
-				return nil, errors.New("cos takes only 1 argument")
-			}
-			p, ok := args[0].(float64)
-			if !ok {
-				return nil, errors.New("argument is not of type float64")
-			}
-			return math.Cos(p), nil
-		}),
-		"pi": govaluate.ExpressionFunction(func(args ...interface{}) (interface{}, error) {
-			if len(args) > 0 {
-				return nil, errors.New("pi does not require any arguments")
-			}
-			return math.Pi, nil
-		}),
-		"sin": govaluate.ExpressionFunction(func(args ...interface{}) (interface{}, error) {
-			if len(args) != 1 {
-				return nil, errors.New("sin takes only 1 argument")
-			}
-			p, ok := args[0].(float64)
-			if !ok {
-				return nil, errors.New("argument is not of type float64")
-			}
-			return math.Sin(p), nil
-		}),
+	var events []service.EventSpec
+	for _, g := range expr.Split(r.Form.Get("events")) {
+		events = append(events, service.EventSpec{G: g, Terminal: r.Form.Get("stop") == "true"})
 	}
 
-	fxyExpr, err := govaluate.NewEvaluableExpressionWithFunctions(fxyStr, funcs)
-	if err != nil {
-		return parsedFuncs{}, errors.Wrap(err, "can't parse f(x,y)")
-	}
-	fxy := func(x, y float64) (float64, error) {
-		params := map[string]interface{}{"x": x, "y": y}
-		resExpr, err := fxyExpr.Evaluate(params)
-		if err != nil {
-			return 0, errors.Wrap(err, "failed to evaluate expression")
+	// size of the plots is optional, the defaults are used for the empty fields
+	var width, height float64
+	var dpi int
+	for name, v := range map[string]interface{}{"width": &width, "height": &height, "dpi": &dpi} {
+		if r.Form.Get(name) == "" {
+			continue
 		}
-		res, ok := resExpr.(float64)
-		if !ok {
-			return 0, errors.Wrap(err, "result is not float64")
+		if err := json.Unmarshal([]byte(r.Form.Get(name)), v); err != nil {
+			return solveRequest{}, errors.Wrapf(err, "can't read %s", name)
 		}
-		return res, nil
 	}
-
-	yxcExpr, err := govaluate.NewEvaluableExpressionWithFunctions(yxcStr, funcs)
+	plotter, err := graph.NewPlotter(r.Form.Get("format"), width, height, dpi)
 	if err != nil {
-		return parsedFuncs{}, errors.Wrap(err, "can't parse y(x,c)")
+		return solveRequest{}, errors.Wrap(err, "can't read plot options")
 	}
-
-	cExpr, err := govaluate.NewEvaluableExpressionWithFunctions(cStr, funcs)
+	if plotter.Options, err = readPlotOptions(r); err != nil {
+		return solveRequest{}, errors.Wrap(err, "can't read plot options")
+	}
+	phaseY0, err := readPoints(r.Form.Get("phase_y0"))
+	if err != nil {
+		return solveRequest{}, errors.Wrap(err, "can't read initial points of the phase portrait")
+	}
+	familyX0, err := service.ParseSweep(r.Form.Get("family_x0"))
 	if err != nil {
-		return parsedFuncs{}, errors.Wrap(err, "can't parse c(x0,y0)")
+		return solveRequest{}, errors.Wrap(err, "can't read x0 of family")
+	}
+	familyY0, err := service.ParseSweep(r.Form.Get("family_y0"))
+	if err != nil {
+		return solveRequest{}, errors.Wrap(err, "can't read y0 of family")
+	}
+	slopeField := r.Form.Get("slope_field")
+	switch slopeField {
+	case "none":
+		slopeField = ""
+	case "", "solutions", "alone":
+	default:
+		return solveRequest{}, errors.Errorf("unknown slope field mode %q", slopeField)
 	}
 
-	yxc := func(x, c float64) (float64, error) {
-		params := map[string]interface{}{"x": x, "c": c}
-		resExpr, err := yxcExpr.Evaluate(params)
-		if err != nil {
-			return 0, errors.Wrap(err, "failed to evaluate expression")
-		}
-		res, ok := resExpr.(float64)
-		if !ok {
-			return 0, errors.Wrap(err, "result is not float64")
+	return solveRequest{
+		X0:       x0,
+		Y0:       y0,
+		Y0Sys:    y0Sys,
+		XEnd:     xEnd,
+		N:        n,
+		NMax:     nmax,
+		NMin:     nmin,
+		fxy:      r.Form.Get("fxy"),
+		equation: r.Form.Get("equation"),
+		methods:  solver.ParseMethods(strings.Join(r.Form["methods"], ",")),
+		yxc:      r.Form.Get("yxc"),
+		c:        r.Form.Get("c"),
+		relative: r.Form.Get("relative") == "true",
+		norm:     r.Form.Get("norm"),
+		events:   events,
+		plotter:  plotter,
+
+		slopeField: slopeField,
+		familyX0:   familyX0,
+		familyY0:   familyY0,
+		phase:      r.Form.Get("phase") == "true",
+		phaseY0:    phaseY0,
+	}, nil
+}
+
+// readPoints reads the points, separated by ';', the coordinates of each point are separated
+// by ',' and optionally enclosed in parentheses, e.g. "(1, 0); (0.5, 0.5)"
+func readPoints(s string) ([]num.Vector, error) {
+	var res []num.Vector
+	for i, item := range expr.Split(s) {
+		var pt num.Vector
+		for j, v := range strings.Split(strings.Trim(item, "() "), ",") {
+			var c float64
+			if err := json.Unmarshal([]byte(strings.TrimSpace(v)), &c); err != nil {
+				return nil, errors.Wrapf(err, "can't read coordinate %d of point %d", j+1, i+1)
+			}
+			pt = append(pt, c)
 		}
-		return res, nil
+		res = append(res, pt)
 	}
+	return res, nil
+}
 
-	cx0y0 := func(x0, y0 float64) (float64, error) {
-		params := map[string]interface{}{"x0": x0, "y0": y0}
-		resExpr, err := cExpr.Evaluate(params)
-		if err != nil {
-			return 0, errors.Wrap(err, "failed to evaluate expression")
+// readPlotOptions reads the axes and the styles of the plots from the parsed form, the ranges of the axes are
+// optional, the styles of the series are separated by ';' in the order of the lines, e.g. "dashed circle; dotted"
+func readPlotOptions(r *http.Request) (graph.Options, error) {
+	opts := graph.Options{
+		LogX:   r.Form.Get("log_x") == "true",
+		LogY:   r.Form.Get("log_y") == "true",
+		Grid:   r.Form.Get("grid") == "true",
+		Legend: graph.Legend(r.Form.Get("legend")),
+	}
+	bounds := map[string]**float64{"x_min": &opts.XMin, "x_max": &opts.XMax, "y_min": &opts.YMin, "y_max": &opts.YMax}
+	for name, bound := range bounds {
+		if r.Form.Get(name) == "" {
+			continue
 		}
-		res, ok := resExpr.(float64)
-		if !ok {
-			return 0, errors.Wrap(err, "result is not float64")
+		var v float64
+		if err := json.Unmarshal([]byte(r.Form.Get(name)), &v); err != nil {
+			return graph.Options{}, errors.Wrapf(err, "can't read %s", name)
 		}
-		return res, nil
+		*bound = &v
 	}
 
-	return parsedFuncs{fxy: fxy, yxc: yxc, cx0y0: cx0y0}, nil
+	var err error
+	if opts.Styles, err = graph.ParseStyles(r.Form.Get("styles")); err != nil {
+		return graph.Options{}, err
+	}
+	return opts, opts.Validate()
 }
@@ -0,0 +1,826 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"image/png"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Semior001/decompract/app/num"
+	"github.com/Semior001/decompract/app/num/graph"
+	"github.com/Semior001/decompract/app/num/service"
+	"github.com/Semior001/decompract/app/num/solver"
+)
+
+// y' = x^2 - 2y
+func prepTestRest() *Rest {
+	return &Rest{
+		Version: "test",
+		Plotter: graph.Plotter{},
+		Methods: []string{solver.MethodRK4},
+		Default: service.Problem{
+			F: func(x, y float64) (float64, error) { return x*x - 2.0*y, nil },
+			Exact: &solver.Exact{
+				F: func(x, c float64) (float64, error) { return c*math.Exp(-2*x) + x*x/2 - x/2 + 0.25, nil },
+				C: func(x0, y0 float64) (float64, error) { return (y0 - x0*x0/2 + x0/2 - 0.25) / math.Exp(-2*x0), nil },
+			},
+		},
+	}
+}
+
+func TestRest_ConcurrentProblemsIsolation(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	// the exact solution of the default problem at x=1 with y(0)=1
+	defaultY := 0.25 + 0.75*math.Exp(-2)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 40; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// every third request solves the default problem, others solve y' = k, y = y0 + k(x-x0)
+			req := problemReq{ProblemSpec: service.ProblemSpec{X0: 0, Y0: 1, XEnd: 1, N: 10}}
+			expected := defaultY
+			if i%3 != 0 {
+				req.Fxy = fmt.Sprintf("%d", i)
+				req.Yxc = fmt.Sprintf("c + %d*x", i)
+				req.C = fmt.Sprintf("y0 - %d*x0", i)
+				expected = 1 + float64(i)
+			}
+
+			b, err := json.Marshal(req)
+			if !assert.NoError(t, err) {
+				return
+			}
+			resp, err := http.Post(ts.URL+"/api/v1/solve", "application/json", bytes.NewReader(b))
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer resp.Body.Close()
+			if !assert.Equal(t, http.StatusOK, resp.StatusCode, "request %d", i) {
+				return
+			}
+
+			var res linesResp
+			if !assert.NoError(t, json.NewDecoder(resp.Body).Decode(&res)) {
+				return
+			}
+			if !assert.Equal(t, 2, len(res.Lines), "request %d", i) {
+				return
+			}
+			for _, line := range res.Lines {
+				last := line.Points[len(line.Points)-1]
+				assert.InDelta(t, expected, last.Y, 0.0001, "request %d, line %s", i, line.Name)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestRest_ConcurrentFormIsolation(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	maxErr := regexp.MustCompile(`<td>Runge-Kutta&#39;s method</td>\s*<td>([^<]+)</td>`)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 12; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// every third request solves the default problem, others solve y' = k, y = y0 + k(x-x0),
+			// which is solved exactly by the method, so the errors of the default problem show the mix-up
+			form := url.Values{"x0": {"0"}, "y0": {"1"}, "x_end": {"1"}, "n": {"10"}, "nmin": {"5"}, "nmax": {"6"}}
+			if i%3 != 0 {
+				form.Set("fxy", fmt.Sprintf("%d", i))
+				form.Set("yxc", fmt.Sprintf("c + %d*x", i))
+				form.Set("c", fmt.Sprintf("y0 - %d*x0", i))
+			}
+
+			resp, err := http.PostForm(ts.URL+"/", form)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer resp.Body.Close()
+			body, err := ioutil.ReadAll(resp.Body)
+			if !assert.NoError(t, err) || !assert.Equal(t, http.StatusOK, resp.StatusCode, "request %d", i) {
+				return
+			}
+			assert.NotContains(t, string(body), "Warning:", "request %d", i)
+
+			m := maxErr.FindStringSubmatch(string(body))
+			if !assert.Equal(t, 2, len(m), "request %d", i) {
+				return
+			}
+			v, err := strconv.ParseFloat(html.UnescapeString(m[1]), 64)
+			if !assert.NoError(t, err) {
+				return
+			}
+			if i%3 == 0 {
+				assert.Greater(t, v, 1e-9, "request %d", i)
+				return
+			}
+			assert.Contains(t, string(body), fmt.Sprintf("f(x,y) = %d;", i), "request %d", i)
+			assert.Less(t, v, 1e-9, "request %d", i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestRest_Solve(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	resp, err := http.Post(ts.URL+"/api/v1/solve", "application/json",
+		strings.NewReader(`{"x0":0,"y0":1,"x_end":1,"n":10,"methods":["heun","euler"]}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var res linesResp
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	assert.Equal(t, []string{"heun", "euler"}, res.Methods)
+	require.Equal(t, 3, len(res.Lines))
+	assert.Equal(t, "Heun's method", res.Lines[0].Name)
+	assert.Equal(t, "Euler's method", res.Lines[1].Name)
+	assert.Equal(t, "Exact solution", res.Lines[2].Name)
+	assert.Equal(t, 11, len(res.Lines[1].Points))
+	assert.InDelta(t, 0.8, res.Lines[1].Points[1].Y, 0.00001)
+
+	// backward integration from x0=1 to x_end=0 returns to the initial value
+	resp, err = http.Post(ts.URL+"/api/v1/solve", "application/json",
+		strings.NewReader(fmt.Sprintf(`{"x0":1,"y0":%v,"x_end":0,"n":100,"methods":["rk4"]}`,
+			res.Lines[2].Points[10].Y)))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var back linesResp
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&back))
+	require.Equal(t, 2, len(back.Lines))
+	for _, line := range back.Lines {
+		require.Equal(t, 101, len(line.Points), line.Name)
+		assert.Equal(t, 1.0, line.Points[0].X, line.Name)
+		assert.Equal(t, 0.0, line.Points[100].X, line.Name)
+		assert.InDelta(t, 1, line.Points[100].Y, 1e-6, line.Name)
+	}
+
+	tbl := []struct {
+		path string
+		body string
+		code int
+	}{
+		{path: "/api/v1/solve", body: `{"x0":0`, code: 1},
+		{path: "/api/v1/solve", body: `{"x0":0,"y0":1,"x_end":1,"n":0}`, code: 2},
+		{path: "/api/v1/lte", body: `{"x0":1,"y0":1,"x_end":1,"n":10}`, code: 2},
+		{path: "/api/v1/gte", body: `{"x0":0,"y0":1,"x_end":1,"nmin":10,"nmax":5}`, code: 2},
+		{path: "/api/v1/solve", body: `{"x0":0,"y0":1,"x_end":1,"n":10,"methods":["unknown"]}`, code: 2},
+		{path: "/api/v1/solve", body: `{"x0":0,"y0":1,"x_end":1,"n":10,"fxy":"x+","yxc":"x","c":"1"}`, code: 2},
+		// exact solution without the equation is not solved as the default problem
+		{path: "/api/v1/solve", body: `{"x0":0,"y0":1,"x_end":1,"n":10,"yxc":"c*exp(-x)","c":"y0"}`, code: 2},
+		{path: "/api/v1/lte", body: `{"x0":0,"y0":1,"x_end":1,"n":10,"c":"y0"}`, code: 2},
+		{path: "/api/v1/gte", body: `{"x0":0,"y0":1,"x_end":1,"nmin":5,"nmax":10,"yxc":"c*exp(-x)"}`, code: 2},
+	}
+
+	for _, entry := range tbl {
+		resp, err := http.Post(ts.URL+entry.path, "application/json", strings.NewReader(entry.body))
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, entry.body)
+
+		var e struct {
+			Code int `json:"code"`
+		}
+		require.NoError(t, json.Unmarshal(body, &e), entry.body)
+		assert.Equal(t, entry.code, e.Code, entry.body)
+	}
+}
+
+func TestRest_ReferenceSolution(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	// y' = y, y = exp(x), without the exact solution
+	resp, err := http.Post(ts.URL+"/api/v1/solve", "application/json",
+		strings.NewReader(`{"fxy":"y","x0":0,"y0":1,"x_end":1,"n":10,"methods":["rk4"]}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var res linesResp
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	require.Equal(t, 2, len(res.Lines))
+	assert.Equal(t, "Reference solution (numerical)", res.Lines[1].Name)
+	for _, pt := range res.Lines[1].Points {
+		assert.InDelta(t, math.Exp(pt.X), pt.Y, 1e-10)
+	}
+
+	// only a part of the exact solution is specified
+	resp, err = http.Post(ts.URL+"/api/v1/solve", "application/json",
+		strings.NewReader(`{"fxy":"y","yxc":"c*exp(x)","x0":0,"y0":1,"x_end":1,"n":10}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestRest_LTEAndGTE(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	resp, err := http.Post(ts.URL+"/api/v1/lte", "application/json",
+		strings.NewReader(`{"x0":0,"y0":1,"x_end":1,"n":10}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var res linesResp
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	require.Equal(t, 1, len(res.Lines))
+	// local errors start from the first step
+	require.Equal(t, 10, len(res.Lines[0].Points))
+	assert.InDelta(t, 0.1, res.Lines[0].Points[0].X, 1e-12)
+	assert.InDelta(t, 0, res.Lines[0].Points[0].Y, 1e-5)
+
+	// LTE of Euler's method is O(h^2), while its global error is O(h)
+	lte := func(n int) float64 {
+		resp, err := http.Post(ts.URL+"/api/v1/lte", "application/json",
+			strings.NewReader(fmt.Sprintf(`{"x0":0,"y0":1,"x_end":1,"n":%d,"methods":["euler"]}`, n)))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var res linesResp
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		return res.Lines[0].Points[0].Y
+	}
+	assert.InDelta(t, 4, lte(100)/lte(200), 0.1)
+
+	resp, err = http.Post(ts.URL+"/api/v1/pointwise", "application/json",
+		strings.NewReader(`{"x0":0,"y0":1,"x_end":1,"n":10,"methods":["euler"]}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	require.Equal(t, 1, len(res.Lines))
+	require.Equal(t, 11, len(res.Lines[0].Points))
+	assert.Equal(t, 0.0, res.Lines[0].Points[0].Y)
+	// global error accumulates, so it's greater than the error of the single step
+	assert.Greater(t, res.Lines[0].Points[10].Y, lte(10))
+
+	resp, err = http.Post(ts.URL+"/api/v1/gte", "application/json",
+		strings.NewReader(`{"x0":0,"y0":1,"x_end":1,"nmin":5,"nmax":10}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	require.Equal(t, 1, len(res.Lines))
+	require.Equal(t, 6, len(res.Lines[0].Points))
+	assert.Equal(t, 5.0, res.Lines[0].Points[0].X)
+	assert.Greater(t, res.Lines[0].Points[0].Y, res.Lines[0].Points[5].Y)
+
+	// the numbers of steps and the body size are limited
+	for _, tt := range []struct{ name, path, body string }{
+		{"n", "/api/v1/lte", `{"x0":0,"y0":1,"x_end":1,"n":100000000}`},
+		{"nmax", "/api/v1/gte", `{"x0":0,"y0":1,"x_end":1,"nmin":1,"nmax":1000000}`},
+		{"grids", "/api/v1/gte", `{"x0":0,"y0":1,"x_end":1,"nmin":1,"nmax":5000}`},
+		{"body", "/api/v1/lte", `{"x0":0,"y0":1,"x_end":1,"n":10,"fxy":"` + strings.Repeat("x+", maxBodySize) + `x"}`},
+	} {
+		resp, err = http.Post(ts.URL+tt.path, "application/json", strings.NewReader(tt.body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, tt.name)
+	}
+}
+
+func TestRest_Convergence(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	resp, err := http.Post(ts.URL+"/api/v1/convergence", "application/json",
+		strings.NewReader(`{"x0":0,"y0":1,"x_end":1,"nmin":10,"nmax":30,"methods":["rk4","heun"]}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var res struct {
+		Lines       []num.Line            `json:"lines"`
+		Convergence []service.Convergence `json:"convergence"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	require.Equal(t, 2, len(res.Lines))
+	require.Equal(t, 2, len(res.Convergence))
+	assert.Equal(t, "Runge-Kutta's method", res.Convergence[0].Name)
+	assert.InDelta(t, 4, res.Convergence[0].Order, 0.1)
+	assert.InDelta(t, 2, res.Convergence[1].Order, 0.1)
+	assert.Equal(t, 21, res.Convergence[1].Points)
+}
+
+func TestRest_Metrics(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	post := func(body string) *http.Response {
+		resp, err := http.Post(ts.URL+"/api/v1/metrics", "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := post(`{"x0":0,"y0":1,"x_end":1,"n":10,"methods":["euler"],"relative":true}`)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var res struct {
+		Relative bool              `json:"relative"`
+		Metrics  []service.Metrics `json:"metrics"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	assert.True(t, res.Relative)
+	require.Equal(t, 1, len(res.Metrics))
+	assert.Equal(t, "Euler's method", res.Metrics[0].Name)
+	assert.Greater(t, res.Metrics[0].Max, 0.0)
+	assert.LessOrEqual(t, res.Metrics[0].RMS, res.Metrics[0].Max)
+
+	resp = post(`{"x0":0,"y0":1,"x_end":1,"n":10,"norm":"l3"}`)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// gte in the endpoint norm equals the endpoint error on the same grid
+	resp, err := http.Post(ts.URL+"/api/v1/gte", "application/json",
+		strings.NewReader(`{"x0":0,"y0":1,"x_end":1,"nmin":10,"nmax":10,"methods":["euler"],"relative":true,"norm":"end"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var gte linesResp
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&gte))
+	require.Equal(t, 1, len(gte.Lines))
+	assert.InDelta(t, res.Metrics[0].End, gte.Lines[0].Points[0].Y, 1e-12)
+}
+
+func TestRest_ExactSolutionWarnings(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	post := func(body string) linesResp {
+		resp, err := http.Post(ts.URL+"/api/v1/solve", "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var res linesResp
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		return res
+	}
+
+	res := post(`{"x0":0,"y0":1,"x_end":1,"n":10,"fxy":"x","yxc":"x*x/2 + c","c":"y0 - x0*x0/2"}`)
+	assert.Empty(t, res.Warnings)
+
+	// typo in the exact solution
+	res = post(`{"x0":0,"y0":1,"x_end":1,"n":10,"fxy":"x","yxc":"x*x/3 + c","c":"y0 - x0*x0/2"}`)
+	require.Equal(t, 1, len(res.Warnings))
+	assert.Contains(t, res.Warnings[0], "exact solution doesn't satisfy the equation")
+	assert.Contains(t, res.Warnings[0], "at x = 0.995")
+
+	resp, err := http.PostForm(ts.URL+"/", url.Values{
+		"x0": {"0"}, "y0": {"1"}, "x_end": {"1"}, "n": {"10"}, "nmin": {"5"}, "nmax": {"7"},
+		"fxy": {"x"}, "yxc": {"x*x/3 + c"}, "c": {"y0 - x0*x0/2"},
+	})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "Warning: exact solution doesn&#39;t satisfy the equation")
+}
+
+func TestRest_Events(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	// y' = x, y(0) = 1, y = x^2/2 + 1 crosses 1.125 at x = 0.5
+	problem := `"x0":0,"y0":1,"x_end":1,"n":10,"methods":["rk4","euler"],"fxy":"x","yxc":"x*x/2 + c","c":"y0 - x0*x0/2"`
+	resp, err := http.Post(ts.URL+"/api/v1/solve", "application/json",
+		strings.NewReader(`{`+problem+`,"events":[{"g":"y - 1.125"},{"g":"x - 0.75","terminal":true}]}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var res linesResp
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	require.Equal(t, 3, len(res.Lines))
+	require.Equal(t, 2, len(res.Events))
+	assert.Equal(t, "Runge-Kutta's method", res.Events[0].Name)
+	require.Equal(t, 2, len(res.Events[0].Events))
+	assert.Equal(t, "y - 1.125", res.Events[0].Events[0].Event)
+	assert.InDelta(t, 0.5, res.Events[0].Events[0].X, 1e-9)
+	assert.InDelta(t, 1.125, res.Events[0].Events[0].Y, 1e-12)
+	assert.True(t, res.Events[0].Events[1].Terminal)
+	for _, line := range res.Lines {
+		assert.InDelta(t, 0.75, line.Points[len(line.Points)-1].X, 1e-12, line.Name)
+	}
+
+	// the events are not located by the other requests, but they stop the solutions
+	resp, err = http.Post(ts.URL+"/api/v1/pointwise", "application/json",
+		strings.NewReader(`{`+problem+`,"events":[{"g":"x - 0.75","terminal":true}]}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	res = linesResp{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	assert.Empty(t, res.Events)
+	require.Equal(t, 2, len(res.Lines))
+	assert.Equal(t, 9, len(res.Lines[0].Points))
+
+	resp, err = http.Post(ts.URL+"/api/v1/solve", "application/json",
+		strings.NewReader(`{`+problem+`,"events":[{"g":"y +"}]}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestRest_PlotGraphs(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	resp, err := http.PostForm(ts.URL+"/", url.Values{
+		"x0": {"0"}, "y0": {"1"}, "x_end": {"1"}, "n": {"10"}, "nmin": {"5"}, "nmax": {"7"},
+		"fxy": {"x"}, "yxc": {"x*x/2 + c"}, "c": {"y0 - x0*x0/2"}, "methods": {"heun,euler"},
+	})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "Methods: heun, euler")
+	// each image is shown and linked for download
+	assert.Equal(t, 8, strings.Count(string(body), "data:image/png;base64,"))
+	assert.Contains(t, string(body), `download="gte.png"`)
+	assert.NotContains(t, string(body), "slope_field")
+	assert.Contains(t, string(body), "<td>Heun&#39;s method</td>")
+	assert.Contains(t, string(body), "Errors: absolute; GTE norm: max norm")
+	assert.Contains(t, string(body), "<th>RMS</th>")
+	assert.NotContains(t, string(body), "Warning:")
+	assert.NotContains(t, string(body), "<th>Event</th>")
+
+	// events, the solutions are stopped at the first one
+	resp, err = http.PostForm(ts.URL+"/", url.Values{
+		"x0": {"0"}, "y0": {"1"}, "x_end": {"1"}, "n": {"10"}, "nmin": {"5"}, "nmax": {"7"},
+		"fxy": {"x"}, "yxc": {"x*x/2 + c"}, "c": {"y0 - x0*x0/2"}, "methods": {"heun"},
+		"events": {"y - 1.125; x - 0.9"}, "stop": {"true"},
+	})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "<th>Event</th>")
+	assert.Contains(t, string(body), "<td>y - 1.125 (stopped)</td>")
+	assert.Contains(t, string(body), "<td>0.5</td>")
+	assert.NotContains(t, string(body), "x - 0.9")
+
+	// system of equations
+	resp, err = http.PostForm(ts.URL+"/", url.Values{
+		"x0": {"0"}, "y0": {"0; 1"}, "x_end": {"1"}, "n": {"10"}, "nmin": {"5"}, "nmax": {"7"},
+		"fxy": {"y2; -y1"},
+	})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "Methods: rk4")
+	assert.Equal(t, 2, strings.Count(string(body), "base64,"))
+
+	// phase portraits of the systems of two equations with the equilibria
+	for _, vals := range []url.Values{
+		{"fxy": {"y1 - y1*y2; y1*y2 - y2"}, "y0": {"0.5; 0.5"}, "phase_y0": {"(1.5, 1); 0.05, 0"}},
+		{"equation": {"y'' + 0.2*y' + sin(y) = 0"}, "y0": {"1; 0"}},
+	} {
+		form := url.Values{"x0": {"0"}, "x_end": {"3"}, "n": {"300"}, "nmin": {"5"}, "nmax": {"7"}, "phase": {"true"}}
+		for k, v := range vals {
+			form[k] = v
+		}
+		resp, err = http.PostForm(ts.URL+"/", form)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err = ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		assert.Equal(t, 4, strings.Count(string(body), "base64,"))
+		assert.Contains(t, string(body), `download="phase.png"`)
+		assert.Contains(t, string(body), "<th>Equilibrium y1</th>")
+	}
+
+	// phase portrait of the wrong systems
+	for _, tt := range []struct {
+		code int
+		vals url.Values
+	}{
+		{http.StatusBadRequest, url.Values{"fxy": {"y2; -y1; y3"}, "y0": {"1; 0; 1"}}},
+		{http.StatusBadRequest, url.Values{"fxy": {"y2; -y1 + sin(x)"}, "y0": {"1; 0"}}}, // not autonomous
+		{http.StatusForbidden, url.Values{"fxy": {"y2; -y1"}, "y0": {"1; 0"}, "phase_y0": {"(1, a)"}}},
+	} {
+		form := url.Values{"x0": {"0"}, "x_end": {"1"}, "n": {"10"}, "nmin": {"5"}, "nmax": {"7"}, "phase": {"true"}}
+		for k, v := range tt.vals {
+			form[k] = v
+		}
+		resp, err = http.PostForm(ts.URL+"/", form)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, tt.code, resp.StatusCode, tt.vals)
+	}
+
+	// vector formats, pdf is only downloaded
+	for format, mime := range map[string]string{"svg": "image/svg+xml", "pdf": "application/pdf"} {
+		resp, err = http.PostForm(ts.URL+"/", url.Values{
+			"x0": {"0"}, "y0": {"1"}, "x_end": {"1"}, "n": {"10"}, "nmin": {"5"}, "nmax": {"7"},
+			"fxy": {"x"}, "yxc": {"x*x/2 + c"}, "c": {"y0 - x0*x0/2"}, "methods": {"euler"},
+			"format": {format}, "width": {"4"}, "height": {"3"},
+		})
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err = ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, format)
+		// '+' is escaped in the attributes by the template
+		assert.Contains(t, string(body), "data:"+strings.ReplaceAll(mime, "+", "&#43;")+";base64,", format)
+		assert.Contains(t, string(body), `download="solutions.`+format+`"`, format)
+		assert.Equal(t, format == "pdf", strings.Contains(string(body), "can't be shown"), format)
+	}
+
+	// axes and styles
+	resp, err = http.PostForm(ts.URL+"/", url.Values{
+		"x0": {"0"}, "y0": {"1"}, "x_end": {"1"}, "n": {"10"}, "nmin": {"5"}, "nmax": {"7"},
+		"fxy": {"x"}, "yxc": {"x*x/2 + c"}, "c": {"y0 - x0*x0/2"}, "methods": {"heun,euler"},
+		"log_y": {"true"}, "x_min": {"0.1"}, "y_max": {"10"}, "grid": {"true"}, "legend": {"top-left"},
+		"styles": {"dashed circle; no-line"}, "slope_field": {"solutions"},
+	})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), `download="slope_field.png"`)
+
+	// family of solutions
+	resp, err = http.PostForm(ts.URL+"/", url.Values{
+		"x0": {"0"}, "y0": {"1"}, "x_end": {"1"}, "n": {"10"}, "nmin": {"5"}, "nmax": {"7"},
+		"fxy": {"-y"}, "family_x0": {"0, 0.5"}, "family_y0": {"-1:1:3"},
+	})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	assert.Contains(t, string(body), `download="family.png"`)
+
+	// family, that can't be solved
+	for _, vals := range []url.Values{{"family_x0": {"0, 1"}}, {"family_y0": {"1:1:3"}}} {
+		form := url.Values{"x0": {"0"}, "y0": {"1"}, "x_end": {"1"}, "n": {"10"}, "nmin": {"5"}, "nmax": {"7"}}
+		for k, v := range vals {
+			form[k] = v
+		}
+		resp, err = http.PostForm(ts.URL+"/", form)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, vals)
+	}
+
+	// invalid interval and numbers of steps
+	for _, vals := range []url.Values{{"n": {"0"}}, {"n": {"-1"}}, {"x_end": {"0"}}, {"nmin": {"8"}}, {"nmin": {"0"}}} {
+		form := url.Values{"x0": {"0"}, "y0": {"1"}, "x_end": {"1"}, "n": {"10"}, "nmin": {"5"}, "nmax": {"7"}}
+		for k, v := range vals {
+			form[k] = v
+		}
+		resp, err = http.PostForm(ts.URL+"/", form)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, vals)
+	}
+
+	// invalid plot options
+	for _, vals := range []url.Values{{"format": {"gif"}}, {"family_y0": {"1:2"}}, {"width": {"-1"}}, {"dpi": {"abc"}},
+		{"styles": {"wavy"}}, {"legend": {"middle"}}, {"slope_field": {"isoclines"}}, {"log_y": {"true"}, "y_min": {"0"}}, {"x_max": {"abc"}}} {
+		form := url.Values{"x0": {"0"}, "y0": {"1"}, "x_end": {"1"}, "n": {"10"}, "nmin": {"5"}, "nmax": {"7"}}
+		for k, v := range vals {
+			form[k] = v
+		}
+		resp, err = http.PostForm(ts.URL+"/", form)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode, vals)
+	}
+}
+
+func TestRest_Plot(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	post := func(kind string, req problemReq) (*http.Response, []byte) {
+		b, err := json.Marshal(req)
+		require.NoError(t, err)
+		resp, err := http.Post(ts.URL+"/api/v1/plot/"+kind, "application/json", bytes.NewReader(b))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, body
+	}
+
+	tbl := []struct {
+		kind, format, mime, magic string
+	}{
+		{"solutions", "", "image/png", "\x89PNG"},
+		{"lte", "svg", "image/svg+xml", "<?xml"},
+		{"pointwise", "PDF", "application/pdf", "%PDF"},
+		{"gte", "eps", "application/postscript", "%%!PS-Adobe"}, // sic, as written by gonum
+	}
+	for _, tt := range tbl {
+		resp, body := post(tt.kind, problemReq{ProblemSpec: service.ProblemSpec{X0: 0, Y0: 1, XEnd: 1, N: 10, NMin: 5, NMax: 7},
+			Image: imageReq{Format: tt.format, Width: 4, Height: 3}})
+		require.Equal(t, http.StatusOK, resp.StatusCode, "%s: %s", tt.kind, string(body))
+		assert.Equal(t, tt.mime, resp.Header.Get("Content-Type"), tt.kind)
+		assert.True(t, bytes.HasPrefix(body, []byte(tt.magic)), tt.kind)
+		assert.Contains(t, resp.Header.Get("Content-Disposition"), tt.kind+".", tt.kind)
+	}
+
+	// size of the png image in pixels
+	resp, body := post("solutions", problemReq{ProblemSpec: service.ProblemSpec{X0: 0, Y0: 1, XEnd: 1, N: 10},
+		Image: imageReq{Width: 2, Height: 1, DPI: 150}})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	cfg, err := png.DecodeConfig(bytes.NewReader(body))
+	require.NoError(t, err)
+	assert.Equal(t, 300, cfg.Width)
+	assert.Equal(t, 150, cfg.Height)
+
+	resp, body = post("unknown", problemReq{ProblemSpec: service.ProblemSpec{X0: 0, Y0: 1, XEnd: 1, N: 10}})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, string(body), `"code":2`)
+
+	// slope field with and without the solutions
+	for _, noSolutions := range []bool{false, true} {
+		resp, body = post("slope_field", problemReq{ProblemSpec: service.ProblemSpec{X0: 0, Y0: 1, XEnd: 1, N: 10},
+			Image: imageReq{Format: "svg", NoSolutions: noSolutions}})
+		require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+		assert.Equal(t, "image/svg+xml", resp.Header.Get("Content-Type"))
+	}
+
+	resp, body = post("family", problemReq{ProblemSpec: service.ProblemSpec{X0: 0, Y0: 1, XEnd: 1, N: 10},
+		Y0s: service.Sweep{Values: []float64{0, 1, 2}}, Image: imageReq{Format: "svg"}})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	assert.Contains(t, resp.Header.Get("Content-Disposition"), "family.svg")
+	resp, _ = post("family", problemReq{ProblemSpec: service.ProblemSpec{X0: 0, Y0: 1, XEnd: 1, N: 10},
+		X0s: service.Sweep{N: 1}})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// axes and styles
+	yMax := 0.5
+	resp, _ = post("lte", problemReq{
+		ProblemSpec: service.ProblemSpec{X0: 0, Y0: 1, XEnd: 1, N: 10, Methods: []string{"euler", "rk4"}},
+		Image: imageReq{Format: "svg", Options: graph.Options{LogY: true, YMax: &yMax, Grid: true,
+			Legend: graph.LegendNone, Styles: []graph.Style{{Line: "dotted", Marker: "none"}}}}})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	yMin := -1.0
+	for _, img := range []imageReq{{Format: "gif"}, {Width: -1}, {DPI: 5000}, {Width: 50, DPI: 300},
+		{Options: graph.Options{Legend: "middle"}}, {Options: graph.Options{LogY: true, YMin: &yMin}}} {
+		resp, _ = post("solutions", problemReq{ProblemSpec: service.ProblemSpec{X0: 0, Y0: 1, XEnd: 1, N: 10},
+			Image: img})
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "%+v", img)
+	}
+}
+
+func TestRest_Phase(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	post := func(path string, req phaseReq) (*http.Response, []byte) {
+		b, err := json.Marshal(req)
+		require.NoError(t, err)
+		resp, err := http.Post(ts.URL+"/api/v1/"+path, "application/json", bytes.NewReader(b))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, body
+	}
+
+	// Lotka-Volterra equations
+	resp, body := post("phase", phaseReq{Fxy: "y1 - y1*y2; y1*y2 - y2", X0: 0, XEnd: 3, N: 300,
+		Points: []num.Vector{{0.5, 0.5}, {0.05, 0}}})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var ph service.Phase
+	require.NoError(t, json.Unmarshal(body, &ph))
+	assert.Equal(t, "Runge-Kutta's method", ph.Method)
+	require.Len(t, ph.Trajectories, 2)
+	assert.Len(t, ph.Trajectories[0].Points, 301)
+	require.Len(t, ph.Equilibria, 2)
+
+	// damped pendulum, the equilibria are at (k*pi, 0)
+	resp, body = post("phase", phaseReq{Equation: "y'' + 0.2*y' + sin(y) = 0", X0: 0, XEnd: 10, N: 100,
+		Points: []num.Vector{{3, 0}}})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	require.NoError(t, json.Unmarshal(body, &ph))
+	require.NotEmpty(t, ph.Equilibria)
+	for _, eq := range ph.Equilibria {
+		assert.InDelta(t, 0, math.Remainder(eq.X, math.Pi), 1e-9)
+		assert.InDelta(t, 0, eq.Y, 1e-9)
+	}
+
+	resp, body = post("phase/plot", phaseReq{Fxy: "y1 - y1*y2; y1*y2 - y2", X0: 0, XEnd: 3, N: 30,
+		Points: []num.Vector{{0.5, 0.5}}, Image: imageReq{Format: "svg", Width: 4}})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	assert.Equal(t, "image/svg+xml", resp.Header.Get("Content-Type"))
+	assert.Contains(t, resp.Header.Get("Content-Disposition"), "phase.svg")
+
+	tbl := []struct {
+		path string
+		req  phaseReq
+		err  string
+	}{
+		{"phase", phaseReq{X0: 0, XEnd: 1, N: 10, Points: []num.Vector{{1, 1}}}, "either fxy or equation must be set"},
+		{"phase", phaseReq{Fxy: "y2; -y1", Equation: "y'' = -y", X0: 0, XEnd: 1, N: 10},
+			"either fxy or equation must be set"},
+		{"phase", phaseReq{Fxy: "y2; -y1", X0: 0, XEnd: 1}, "n=0 must be positive"},
+		{"phase", phaseReq{Fxy: "y2; -y1; y3", X0: 0, XEnd: 1, N: 10},
+			"phase portrait requires the system of two equations, got 3"},
+		{"phase", phaseReq{Equation: "y''' = -y", X0: 0, XEnd: 1, N: 10},
+			"phase portrait requires the equation of the second order, got 3"},
+		{"phase", phaseReq{Fxy: "y2; -y1", X0: 0, XEnd: 1, N: 10}, "phase portrait requires at least one initial point"},
+		{"phase", phaseReq{Fxy: "y2; x", X0: 0, XEnd: 1, N: 10, Points: []num.Vector{{1, 1}}},
+			"phase portrait requires the autonomous system, F2 depends on x at y=[1 1]"},
+		{"phase/plot", phaseReq{Fxy: "y2; -y1", X0: 0, XEnd: 1, N: 10, Points: []num.Vector{{1, 1}},
+			Image: imageReq{Format: "gif"}}, `unknown format \"gif\"`}, // quotes are escaped in json
+	}
+	for i, tt := range tbl {
+		resp, body = post(tt.path, tt.req)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "case %d", i)
+		assert.Contains(t, string(body), tt.err, "case %d", i)
+	}
+}
+
+func TestRest_Family(t *testing.T) {
+	ts := httptest.NewServer(prepTestRest().routes())
+	defer ts.Close()
+
+	post := func(req problemReq) (*http.Response, []byte) {
+		b, err := json.Marshal(req)
+		require.NoError(t, err)
+		resp, err := http.Post(ts.URL+"/api/v1/family", "application/json", bytes.NewReader(b))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, body
+	}
+
+	resp, body := post(problemReq{
+		ProblemSpec: service.ProblemSpec{Fxy: "-y", X0: 0, Y0: 1, XEnd: 1, N: 10, Methods: []string{"euler", "rk4"}},
+		X0s:         service.Sweep{Values: []float64{0, 0.5}},
+		Y0s:         service.Sweep{From: 1, To: 2, N: 3},
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	var fam service.Family
+	require.NoError(t, json.Unmarshal(body, &fam))
+	assert.Equal(t, "Euler's method", fam.Method)
+	require.Len(t, fam.Lines, 6)
+	assert.Equal(t, "x0 = 0.5, y0 = 1.5", fam.Lines[4].Name)
+	require.Len(t, fam.Lines[4].Points, 11)
+	assert.Equal(t, num.Point{X: 0.5, Y: 1.5}, fam.Lines[4].Points[0])
+
+	tbl := []struct {
+		req problemReq
+		err string
+	}{
+		{problemReq{ProblemSpec: service.ProblemSpec{X0: 0, Y0: 1, XEnd: 1, N: 10},
+			X0s: service.Sweep{Values: []float64{1}}}, "x_end=1 must differ from x0=1"},
+		{problemReq{ProblemSpec: service.ProblemSpec{X0: 0, Y0: 1, XEnd: 1, N: 10},
+			Y0s: service.Sweep{From: 0, To: 1, N: 1}},
+			"invalid y0s: number of values n=1 of range must be from 2 to 100"},
+		{problemReq{ProblemSpec: service.ProblemSpec{X0: 0, Y0: 1, XEnd: 1, N: 10},
+			X0s: service.Sweep{From: -1, To: 0, N: 20},
+			Y0s: service.Sweep{From: 0, To: 1, N: 20}}, "family of 400 solutions exceeds 100 solutions"},
+	}
+	for i, tt := range tbl {
+		resp, body = post(tt.req)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "case %d", i)
+		assert.Contains(t, string(body), tt.err, "case %d", i)
+	}
+}
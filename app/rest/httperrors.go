@@ -13,6 +13,17 @@ import (
 
 	"github.com/go-chi/render"
 	log "github.com/go-pkgz/lgr"
+	R "github.com/go-pkgz/rest"
+)
+
+// ErrCode is used to specify the type of the error for the client
+type ErrCode int
+
+// All error codes for UI mapping and translation
+const (
+	ErrInternal   ErrCode = 0 // any internal error
+	ErrDecode     ErrCode = 1 // failed to unmarshal incoming request
+	ErrBadRequest ErrCode = 2 // request contains incorrect data or doesn't contain data
 )
 
 // errTmplData store data for error message
@@ -55,6 +66,17 @@ func SendErrorHTML(w http.ResponseWriter, r *http.Request, httpStatusCode int, e
 	render.HTML(w, r, msg.String())
 }
 
+// SendErrorJSON makes {error: blah, details: blah, code: 0} json body and responds with provided http status code
+func SendErrorJSON(w http.ResponseWriter, r *http.Request, httpStatusCode int, err error, details string, errCode ErrCode) {
+	log.Printf("[WARN] %s", errDetailsMsg(r, httpStatusCode, err, details))
+	render.Status(r, httpStatusCode)
+	var errMsg interface{}
+	if err != nil {
+		errMsg = err.Error()
+	}
+	render.JSON(w, r, R.JSON{"error": errMsg, "details": details, "code": errCode})
+}
+
 func errDetailsMsg(r *http.Request, code int, err error, msg string) string {
 	q := r.URL.String()
 	if qun, e := url.QueryUnescape(q); e == nil {
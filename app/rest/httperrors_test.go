@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"net/http"
@@ -35,3 +36,44 @@ func TestSendErrorHTML(t *testing.T) {
 	assert.Contains(t, string(body), `error details 123456`)
 	assert.Contains(t, string(body), `error 500`)
 }
+
+func TestSendErrorJSON(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/error" {
+			t.Log("http err request", r.URL)
+			SendErrorJSON(w, r, 400, errors.New("error 400"), "error details 123456", ErrBadRequest)
+			return
+		}
+		if r.URL.Path == "/nil" {
+			SendErrorJSON(w, r, 400, nil, "no data", ErrDecode)
+			return
+		}
+		w.WriteHeader(404)
+	}))
+
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/error")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, 400, resp.StatusCode)
+	assert.Equal(t, "application/json; charset=utf-8", resp.Header.Get("Content-Type"))
+	assert.JSONEq(t, `{"error":"error 400","details":"error details 123456","code":2}`, string(body))
+
+	resp, err = http.Get(ts.URL + "/nil")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var e struct {
+		Error   *string `json:"error"`
+		Details string  `json:"details"`
+		Code    ErrCode `json:"code"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&e))
+	assert.Nil(t, e.Error)
+	assert.Equal(t, "no data", e.Details)
+	assert.Equal(t, ErrDecode, e.Code)
+}
@@ -7,6 +7,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00AbfQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00	\x00	\x00blank.gifUT\x05\x00\x01\xfb>\xa5_\x14\xc9\xb1\x11@P\x0c\x00\xd0\x97\x9cE\xf4\x06\xe0\xf4\x9c\xde\x02F\xb2Q\xd6R\xc5\xfd\xfa\x9d\xd7\xb1nO\x08o\xebnT\x15\xe6o\n\xd2\x02\xc3e\xdea\xff\x03\x00\x00\xff\xffPK\x07\x08\xde7\xe4\xf05\x00\x00\x001\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00CbfQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00bottom.pngUT\x05\x00\x01\xfe>\xa5_\x00\xaf\x01P\xfe\x89PNG\x0d\n\x1a\n\x00\x00\x00\x0dIHDR\x00\x00\x03\x02\x00\x00\x00\n\x08\x06\x00\x00\x00\x07\x07\xe9H\x00\x00\x00\x04gAMA\x00\x00\xd6\xd8\xd4OX2\x00\x00\x00\x19tEXtSoftware\x00Adobe ImageReadyq\xc9e<\x00\x00\x01AIDATx\xda\xec\xddQO\x830\x18\x05\xd02\xeb\xfc\xff?W\x19TH\xda\xe4\xb3\x96e>\x0cM<'\xb9\x01\x06|\xcf\xbd\x1bdS)%M\x9b\x94\xd2\x9e\xcb\x96\x97\x9a\xbc\xe5\xb5\xe6\xba\xe5\xadn\xaf\xe1\\\xbb\xf6R\xef\xdf\x03\x00\x00<G\xa9Y\xb7,5\xf3\x96\xdb\x96\x8f\x9a\xf7\xba\x9d\xc3\xb9v\xed~_\xd9\xe5\xc1\xe0\xb5.\xe8\x97\xc1\xe2~\xad\x83rW\x02.\xf5\xbc\"\x00\x00\x00\xcf-\x02m]\x1e\xcb\xc0\xadf\xae%\xe0\x16\n@\xbb\xb6\xc4A\xb9\x1b8*\x03\xb1y,\xa1\x00\xc4\x120)\x02\x00\x00pZ\x11(\x832\x10\x0b\xc1\xa8\x04\xc4\xa4|0\xbc\x0d\xee\x8f\x97A\x01\xf0k\x00\x00\x00\x9c_\x06\xd6;\x85`T\x02\xbe\xc8w\x86\xa6\xae5\xb4\xe1\xb1\x04\xc4\x00\x00\x00\xe7\x95\x81~\xad>J9*\x03\xb9\x1b6\x85\xfd\xf6xP\xe9\x8e\xfb\x17\x83c	P\x08\x00\x00\xe0\xb9\x05\xa0\xdf/\x83\x05\x7f\xff8\xd0\xb7\xfb\xf3\x03\xc3\xa7\xb0=z\x89X	\x00\x00\x80\xf3\xcb@\xea\x16\xfbeP\x00\xcah\xc8\xd1\xa3A\xd3As\xf0\xed?\x00\x00\xfc\xddb\xf0\xc8\xfe\xb0\x08\xa4{\xad\xe1\xe0s\x85\x00\x00\x00~\xb7\x00\xfc\xf8\xfc\xb4\xff\xa1\x18\x00\x00\xf0\xbf|\n0\x00\x94%\x99\x85X\x0c\x06\x0c\x00\x00\x00\x00IEND\xaeB`\x82\x01\x00\x00\xff\xffPK\x07\x08kI\xa2e\xb9\x01\x00\x00\xaf\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00CbfQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0c\x00	\x00iepngfix.htcUT\x05\x00\x01\xfe>\xa5_\xc4U\xe1o\xdb\xb6\x13\xfdn\xc0\xff\xc3\xabQD\xd2\xcf.\xe5\x04\xcdo@\x1cw\x08\xba$0\x96\xa4E\x9ba\x03\x92\x0c\xa0\xa9\x93\xc4E\"\x05\x92\xb2\x9d\xd5\xfd\xdf\x07Rr\x13w\xfd\xbe\x0f\x06\xac\xbb\xe3\xe3\xbbw\xef\xa4\xd3\xa6]VR\x9c\x08]7Z\x91r\xef\x86\x83]\x8c;\xc7E	Z\x91r\xf3\x91V\x8d\xd1\x0d\x19\xf7$J\xae\n\x1aA\xab>\x95\xe9\x0b\xb9\x89\x93\x11\xd2w\xc3\xc1ppj\x85\x91\x8d\x83{jh>r\xb4q\xe9_|\xc5\xbb\xe8(\x94\xa4)\x16\xe7\xc7\xecx\x8c\x8f7\x978\xab\x9a\x92\xe3Bn\xb0:d\xd3O\xef\xdf\x86\x82X$8\x9aN\xdf\xbe9\x9aN\x8fq\xa6\x8a\xd6\xe2\xb65j\xd9V\x15J\xe7\x9a\x934]\xaf\xd7\xcc\xad\xa5*\xa9\x92\x1b&t\xdd\x83\xdf\x96\xd2BZTR\x90\xb2\x94\xa1U\x19\x19\xb8\x92\xf0\xfe\xfd\x9b\xcb\x9b\xdfpu\xf9\xf1j\x82\x15\x19+\xb5\xc2\x11;\x846\xa8\xb8#\xc3\x02\xc2\x856\xc8\xc8qY\xd9	,\xd1\xc9\xeeJa\x88;\xb9\"\xa1\xebZ+\xcb\xb4)\xd2\xfe\x1a\x9bz\xd4\xf4\x88\x1d\xa6\x9e\xc73\x93\xba\xb5\x0eK\x02G\xc3]	\xa7\xc1\xb1\xac\xb8z\x84\xacyA\x0c\xb7%w\x91\x05\xaf\xaa\xc0Qh\x95\xcb\xa25\xdcyrO\xba\x85\"\xca\xd8p s\xc4^V\x9dw\xe7\x17u\x81\xf9\x1c\x91o/\x97\x8a\xb2(\xc1\x8a\x9b\x17ID\xe1?+d\x1e\xcd:V\xbe \xf7\x99_\xfeX\xf8\xebo\x0dW6\xd7\xa6f\xd7R\x18mu\xeeX\x18I\xc8^i\x9e\x91\xe9\xce\xe6\xad\x12\x81R.+\x17\xdb	\xead8\xf82\x1c\xc0\xf3\xf212\xf6.\x7fH\x86\x03\xf8(\x9eC\x8c\x14_V\x94a\x0e\x8b\x9f\xe1LK8A\xce+K3_\xe9\x01l\x82\xb5t\xe5\x1e\x12\xbe\xc0\x1a\xe1O\xcd`\xe5\xdfR\x15\xd7\xe4J\xedqj|\x1d\x0e\xc2\x8f*K;\x04\xeb\x9e*b\x1d\x82\xef\xb11\xba\x90\xd9I4\xce\xc7Ql\x8d\x98\x8f\xa2\xb1\x1dG\xa3\xc9K4\x1f\xac\xc7\xd1(\xf1m~\xdd\xeb\xb4\xf7v\xd7f\x9a\xe2\xcc\xda\xb6&,\xce\x7f\xf2\xfe\xfa\xf0+\xeb\xbb\x7f\x95^\x7f^\x9c#>\xbeg\xc7\xdb\xff\xdf\xb3$e\x8e\xac\x8b\x15_\xc9\x82;mXk\xc9\x9c\x15\xa4\\\x82\xed\xd6\xf7\x1c\x87\xf5\xc1\xc1\x01^\xa5\xf1\x92\x8b\xc7\xc2\xe8Ve[k\xc4\xeet\xa8`\xbb\xc5\xbb\xe15%I\x02C\xae5*L\xa4\x9bv\xd1\x8dZ\xb4\xc6\x90r\x9f\x83\x04\xcf\x80a\x8c\xd8n{m\xbeKt0\xc1X\xbc\xf07\x04C-\xae/\xa3oc\xf4\xc98\xbdg\x8d*^\xa72\xe9\xa8y\x96\xbe\xa2+	5{\xf7\xafe\xe6\xca\x80\xc5[\xa7#\xdf\xe6^\xbe$Y\x94\xee\xb9 `\xa1\xe7\xd8\x1f\x86\xcesK\xee\xf7\xf04F\xd4l\xa2\xe0\x96\xde\x7fFL\x10Y\xc1+\x8a\x92.\xdeye\xe7\xfe\x10\xf3\x06y\xe1\x10#\x98T\x19m>\xe4\xf1\xae,\xc1)\xa6I\x87\x19p\xf6<\xd5\x89{p\xd0\xab\xfcj\x8eHiE\xfb\xea\x84\x1c\xab\xb9\x13e\x9c\xfe\xd9\x9a\xea.\x1eE\x0f\xe3\x98\xfd/\xa8\x96\xdc%\xfe\xd1\x8b\xf7B2?:\x8b9>Qq\xbei\xd8\xeb\xc3\xd9\x7f\xa0\xe4\x0f=\x81]\x93/\xd5\x9e \x12F7;\xa9\xc3+\x1c\x95T\x8f\xc8\xe5\x86u\x85\xda \xf6m)\xcc1\x9dA\xe1\x14\xa2\x94Uv\xa33\xb2\xac\"U\xb8r\x065\x1e\xf7\x1cC\xaf\xdf\n\xee\xd4\x03\x0b|\x12\xfc \xc8\x1ame\xd8\xc99\"CUx\x0bG\xdf\xcdxo\x88a\x93\xfb\x05\x0e6?M\xbb\x8f\x90\xff\xc8\xa5\xff\xfa\xf2\xfd\x13\x00\x00\xff\xffPK\x07\x08#{\xea}\x9a\x03\x00\x00\x0c\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00o\x1agQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00index.htmlUT\x05\x00\x01B\x12\xa6_\xbc\x97\xcf\x93\xda6\x14\xc7\xcf\xf0W\xbc\xeaR\xd8\x022lH\x97\xd4\xf6!\xb0iw&M3-\x99&\xa7\x1da\x0b\xac\xac,9\xd63\xd8\xff}G\xb2\xcd\x9a\xa6\x07h&\x9c,\xc9\xef\xfb}\xfa\xe8\x87-\xf9?\xac\xfeX\xae?\xbd\xbf\x87\x04S	\xef?\xbc~\xfb\xb0\x042\xa6\xf4\xef\xdb%\xa5\xab\xf5\n>\xfe\xb6\xfe\xfd-L'\x1e\xacs\xa6\x8c@\xa1\x15\x93\x94\xde\xbf#@\x12\xc4\xec\x15\xa5\x87\xc3ar\xb8\x9d\xe8|G\xd7\x7f\xd2\xd2zM\xad\xb8)\x8e\xb1\xa3\x9c\xc4\x18\x93\xb0\xef\xbb\x84e*\x95	\xfe\xc3f\xbaX,j\xb5\x8b\xe5,\x0e\xfb=?\xe5\xc8\xc0\x06\x8f\xf9\x97B\xec\x03\xb2\xd4\n\xb9\xc2\xf1\xba\xca8\x81\xa8\xae\x05\x04y\x89\xd4\x8a\x7f\x81(a\xb9\xe1\x18|X\xbf\x19\xdf\x11k\x82\x02%\x0fW\xf7K\x9d\xbe\xcfY\x84>\xad[\xfa=_\n\xf5\x049\x97\x011XIn\x12\xce\x91\x00V\x19o<#c\x08$9\xdf\x06d/\xf8a\xe2\xea)\x8f\x05\x0b\x08\x93\xd2\xf9\x9b(\x17\x19vU\x9f\xd9\x9e\xd5\xad\x04L\x1e5\xda\xcf\x86\x84>\xad\xdb\xc3~\xdf\xa75\xa5\xbf\xd1q\x05\"\x0eH\xca\x84z\xb45\x02a\xbf\xdf\xf3E\xbas\xed\xa8\xb3\xc6\x07u6\xc9\xd4\x8e\x00\x93\x18\x10\x97=\x16{\x17\xb4\xd5y\xfah\x07\x84	\xc5sb\x0dz~2\x0d}v\x82\xceB\x9f&\xd3\xd0\xbe\xb4\x8ag\xe9|\xee\xdd\x11\x88$3& ,\xcb\x94\xc0\\\x13\x80\x94c\xa2\xe3\x80d\xda \x01\x16\xd9I\x0d\x08\xb5\xb9{u\xf6F\xe3\\b^\xf3	\xad\xea\x88\x9e\x9f\xccN:\x90\xcc\x9a\xf6,\\\x89\xed\x96\xe7\\\xa1`\x12\xee\xbf\x14\xcc\xca\x0c,u\x9a\x15\xc8\xea\xd5\x03N&\xa2\"\x1d\xc1'.M\xc2\x10V\x85ybRT|?\x82\xd7\xd3\xc5\xd8{\xe1\xd3\xec\xe8\xfa\x00\x83\xaf\x03\x87\x90\xb0=\x87\x9dF`\n\xee0\x81=\xcb\x05S8\x82C\"\xa2\x04\x84y\xd55\xd9\x0e\xcaQ5\x84\x00\xaa\x9b\xea\x86\x97\xd9\xa0\x1c\xc2\x18f7U7\xa8\x1a\x94\xa3\xc8\x06\xd9\x80q9\x04\n\x83\xa8\x8d\xfe	\xa6\xc3n\xf0rPz#\xa8<\x1b?\xa8\x05\x9e\xf5\xb4-\x14\x06\x95\x077\xce\xa7\xf4\x86'\xba\xca7\xc5&\xf4|j\x1f\xc1\xb4\xfb\xaa<y5>\x19\x85\x8f\x10\xc0\xb1\xc1\xa7\xb1\xd8\xd7\xa5BB\x13#\x85\x9b|)\x1e\x7f&M[\xcf\x97l\xc3e;\xa3\xdd\xc9\x84\xad\xce\x03\xb2-+\xd2\x8c\xcd\x08\x984\x1a\x9e\x94>(`\x06\xaa\x1f\xc1\xa7N\xdez\xb59mY\xa8\xac@\x97\x8fK\x9er\x856\xa9b)\xaf=\xdb\x8c\xcdK\xb0\xbb\xc8m\xb3\"\xednG\x02)+%W;L\x022\x9b\xcf	\xec\x99,x@\x08m\x93\x1eI{>\x95\xe2+\xd4\xbb\xb3Q\xab2\"\xcd\x0c\xff\x1b\x15\x13\x0e\xbcd\x11\x82\xd1\xb2\xb0\x9a\xcb\xd0\xefZt\x9b\xe3j\xe8\x8b\xb3\xd1\xdb~.H\xbdj+o8\xb2\xdfZ\x83'\x1b\x06\x14\xe71\x8f\xad\xe6\x9b\x86c\xd1\x0e\xc7\x15\x07cz\xf6`\x94\x1e	\x1f\x94p\xdf\xa8\x12\x06'{nx\x19\xe8\xb4\x05-\xbd\xefGz\x829;\x1b\xb3\xea`V0\xa8\xbe\x01sv\\\xde\xd7\xc2\xbc=\x1b\xb3|\xe4*&\xe1\xbd\x8a\x85\xda\xd9\xf9\xfcx!\xdc\xedq\x0e\x9d\xd3u\xf8^\x9c\xcd\xa7H\xf8\xaeH7<\x07\xbd\x05\x83<30xw!\xe2\x8b\x16Q]	o~>^*,\xa1[\x9c\xa9P\xf5\xf2\xbc\x8cn~\xa4\xb3^\xd7\x01|y\x01 +\x8f\x80\xac\xfc?\x80/\x9f\x01Y\xf9\xfd\x00\xfb\xc7Oj\x93aS jeH\x1b_\xf7\xacN\x91\x888\xe6\xea\xf8\xc7\xb7'E\x11\x1fs\xd4GO\xdaXv\x99\x0c\xdb\xf37:O\xc9i\x92\xc7\xba\xcb\xb5\xb7)6\xa9\xc0\xd6\xbb\xad5\xd6\x7f5U\xda\xed\xb9+\x14n8}j;\xe3J\xcf\x07i\x8d\xee\x00\xdd\xeb\xf5~\xe5\x8a\xe7\x0cy\x0c\x9b\n|\xd6\xdc\x04:\xb7\x97,\xc9\xac\x81\xbd\xc2\x900\xb3]\xb5G\xec	\xac\x13\xa6\x9e\xa0\xd2\xc5\x08f\xde\xccs\xffHw\x01r\x87\xee]m\xabs3\xb21\xc0\xa4\xd4\x07\x1eC\xca\x015\xc4\x1a\x94F\xd8\x14B\xc6\xee\xac\xf1\xe1\x01^\x0d\xfb\xcfSp|\xb6\x17\x84\x8dF\xd4isG\xa8+\xa7\xd7\x04\x9f\xdak\x85}\xdaN\x84\xff\x04\x00\x00\xff\xffPK\x07\x08LF\x14QN\x04\x00\x00\x06\x0e\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x16\x1cgQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00	\x00	\x00plot.htmlUT\x05\x00\x01]\x15\xa6_\xac\x94ok\xdb:\x14\xc6\xdf\xe7S\x9c\xabK\xa1\x17\xe2\xc8\xb9\x0d\xa57\x96\x0dwi:\x06[WX\x06\xcdK\xc5>\xb6\xb5\xc9\xb2'\x9f\xb8\xf6B\xbe\xfb\xf0\x9f\x14w\x046\xb6\xe5\x8dtt\x8e~\xcf\x89\xf5H\xe2\xaf\xdb\xf7\xab\xcd\xf6a\x0d)e:\x98\x88\xd3\x802\n&\x00\x00\"C\x92`d\x86>\xab\x14>\x15\xb9%\x06an\x08\x0d\xf9\xecIE\x94\xfa\x11V*D\xa7\x0b\x18\x1foL\x89\n\x07\xbf\xecU\xe5\xb3U\xbf\xc9\xd94\x05\x8e\x10\x845\xf1V\xd7\x830\x95\xb6D\xf2?n\xee\x9c\x9bg\x10)\xd2\x18|\xc8\xf5\x9eTn\x04\xef\xe3\x89\xe0}\x97b\x97GM0\x11\x91\xaa\xa0\xa4Fc\x8ft\xa4V\x89YB\x88\x86\xd0z\x10\xe7\x86\x9cXfJ7K\xf8\xdf*\xa9\xa7PJS:%Z\x15\x0f\xf9R}\xc5%\xcco\x8a\xdac\x83z:?Q\x8b\xbcTm\x07K\xb0\xa8%\xa9\n=\x08s\x9d\xdb%\xfc\xbd\x88w\xbb\xe8\xda\x83L\xdaD\x19\x87\xf2b	\xee\xec_\xcc<\x16\xdc\xaeWy\xf6`eH\x82\xa7\xf3\x13\xf6\xeag\xb0\xd7\xdd\xef<v\x8b\xbaL%\xc1\xed\xbe\xfc,\xb5j\xb0\x9a\xc2\xab\xf9\x7f\x8e\xbb\x10<\xbd\x1ad\x8a \xbe\xac\xa7\xcd?\xe0\xc3\xe10\xbb\xab\x9b\xe3\xd1\x83\xe6\xb2\x9e\x86\xc3\xd2\xb6\x0e\xdb\xa5\xd5e-\xca\xfd.p\x05o\x87i3\x8e\x86\xd2U\xed6\xee\xf1(x\xf1\x0c\x7f\xb1\xa9\xab*\xac2\x14\x03\xbb\x98-b\x06\xb3G\xb7\x13\xfcQ\xd9\xb6+{<KX\x9b\xa8M\xde\xf7M\xdcw\xf3\x8e\x97)3\"\xce\xee\xdf)3J\xca\xfaeR\xd6\xe3\xd6%\xa4\x16c\x9fq\x16\xac[\x7f\x8049\xa5h!\x92$\x05\x97\xad\xbd\"U\x05\x13Ar\xa7\x11z\x9f\xb3\xb9\xeb^\xb0\xd3\xc1u\x06s\x06\x1f\xff\xba\xd1\xe0\x9c\x01\xce\x1d\xf8p\x1bl?\xe9\x83(\x10*K\xbek\xcf\x86>k\xff\xc7Re2A\xfe\xa9H\xbc\x9d,\xf1z1=\x1cf\xa7{T\xbe\xc9\x92\xe3\x91\x81\xd4\xe4\xb3\xf2\xb4\x08\x85\xce\x89\x05\x82S\xf4{2o7\xeb\xb1\x80&\xfcc\xe8\xd7/\xd1\xc9\x19\xb4\xe0\xedg\x12\xbc;\xbcv2\xbc\x11\xdd;\x13|\x0b\x00\x00\xff\xffPK\x07\x084\xe7\xb3\xaa4\x02\x00\x00\xf6\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00CbfQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00shadow.gifUT\x05\x00\x01\xfe>\xa5_r\xf7t\xb3\xb0Ldg`bh`d8s\xe6\xcc\xff\xff\xff\x15\x7f\xb202002\xe80000\x80d\x18\x98X[\xf8\x17J\xb32X\x03\x02\x00\x00\xff\xffPK\x07\x08\xc3\x86\xc1\xa33\x00\x00\x00.\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00CbfQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x07\x00	\x00top.pngUT\x05\x00\x01\xfe>\xa5_\x00\xa1\x01^\xfe\x89PNG\x0d\n\x1a\n\x00\x00\x00\x0dIHDR\x00\x00\x03\x02\x00\x00\x00\n\x08\x06\x00\x00\x00\x07\x07\xe9H\x00\x00\x00\x04gAMA\x00\x00\xd6\xd8\xd4OX2\x00\x00\x00\x19tEXtSoftware\x00Adobe ImageReadyq\xc9e<\x00\x00\x013IDATx\xda\xec\xdb\xebn\xc20\x0c\x06\xd0\xa4\x14\xf6\xfe\xaf;\xc6\xb2\x15\xb5\x93\xeb^\xb4!\x95M\xda9\x92I \xe5\xbf?\x1cjk\xad\x00\x00\x00\xffK?\xbc\xd4Z\xf7\x9e\xa9\x0f\x9e\x01\x00\x00\xc7j\x8f\x9c\x0d\xc3\x80\xfe\x1b\xcd}]\xd9W\x81\x00\x00\x00\xfeL\x00h?\x0d\x06\xfdFC\xbf\xb6\xc6\xda\x0b\x05\x00\x00\xc0\xf3\xc2@\\c\xd5t\x16\xdf\xcf\x82\xc0^\xf3\xdf\xa5u+\x14\x00\x00\x00\xcf\x0d\x01\xb1\xde\xd3\x9aC\xc0W\x18\xd8\x9b\x08\xc4\xe6\x7f\xa8S\xd8\xe7P \x0c\x00\x00\xc0\xef\x84\x80\xd8\xfc\x0fu\x1b{\xf3\x1c\n6'\x02%\x85\x80.\x85\x80>\x85\x81\xae,'\x04\x00\x00\xc0\xf1A\xa0\xa5\xe6?\x87\x80\x18\x06J\x99O\x08V\x83@\xbe\x0et\n!\xe0\x9c\x02\xc1\xa9,\xa7\x03\x00\x00\xc0\xf1A \xfe\xda\x7f\x1b\xeb-\x04\x80)\x0cL\xcf\xd7\xb0\x9f\x05\x81|\xc5'N\x02\xce+\x95'\x04\xd3w\x00\x00\x80c\xe5\x100\x05\x80\xebX55\xfe\xf9\x0f\xc4\xf7\xcf\xd7&\x02\xf9:\xd0\xd0\xf8_>\xebe\\/e>!\x88a\xc0T\x00\x00\x00\x8e\xd3\xcar\x12p\x1d\xc3\xc0\xeb\xd8\x9bo\x85\x80\xa9\xee>\x04\x18\x00gz`\x82$L\n\x02\x00\x00\x00\x00IEND\xaeB`\x82\x01\x00\x00\xff\xffPK\x07\x08Q\xf1\xa7\xdc\xab\x01\x00\x00\xa1\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00CbfQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x08\x00	\x00view.cssUT\x05\x00\x01\xfe>\xa5_\xdcZ_o\xe3\xb8\x11\x7f\x8e>\x05\xbbA\x80^\xba\xd6Zv\xec\xc4\xce\xd3\xdd\xee\xe6z\xc0\x16(pE_\x8a\"\xa0E\xda\"\"\x89*E'\xce\x19\xfb\xdd\x0b\xfe\xd5\x90\xa2\xbd\xe9\xb5\x87\xa2\xb5os\x91D\xce\x0c\x7f\xf3\x7f\x94\x0d'\xaf\xd91\xbb\xd8\xe0\xf2i'\xf8\xbe%\xeb\xcb\xad\xfa\xdcg\x17[\xde\xca\xc9\x167\xac~]\xbf\xfb\xb2/\x19\xc1\xe8G\x81[B\xdf\xbdG\x7f\xc1\x15o\xf0{\xf4\xbd`\xb8~\x8f\xfeJ\x05\xc1-~\x8fz\xdc\xf6\x93\x9e\n\xe6)\xf4\xec\x17\xba\xee\x1b\\\xd7\xf7\xd9E\x83\xc5\x8e\xb5\xeb\xbb\xee\x80\xa6\xa8Xv\x87\xfb\xecB\xd2\x83\x9c\xe0\x9a\xed\xdauI[I\xc5}\xf65\xcb.\xb7\\4\x8f%o%f-\x15	!\xef\xb3\x8b\x0d\x17\x84\x8au\xd1\x1dP\xcfkF\xd0eY\x96\x03\x9b)\xc2{\xc9C\x165\xdd\xca\xfb\xec\xe2\x85\x11Y\xad\x977S%\x82b'y\xa7x\x10\xd6w5~]oj^>\xddg\x17\x15e\xbbJ\xae\x0b\xbd\xce\xd1UW\x9a4\x9a\x0e\xa4\x16\x9e\xd4\x96si$\x0e\xb8\\\x945\xc5b\xbd\xe1\xb2R\x17\xbc\xe6b}\xb9\xd2\x9f4\n\xd1\xf6\x0e\x13\xc2\xda\xddd\xc3\xa5\xe4\xcd\x1a\x15\x0b}{\x00\x19\xdd-\xae\xa0\x04\x08\x1fO\xf0!\xb4\xe4\x02K\xc6\xdb5jyK=\x94\x03\xf1\xee\x80\x08\x97\x92\x12\xe4\xf7*\xca\xe6\xf9\x9b\x91\xf2\x1a\x88AR\xda\xcdq\xd7\xb5L\n\xae\xc8\xd9\x0d3\x05\xad\xfe1\x1d\x8e\xbc\x9e\xa2\xa9\xbe\xa9\xb7~\xb8\xbe\xbe\xbeF_\xf8\x8e\xa3\x9fi\xa9\x0e\x81\x90\xbau\xfd!\xab\x8a\xd0N&\xf6\xf4\x84\xaa/\x10K\xfd\xca\xda\x89\x959`\x95\xc0\xc8B\xa4o\xb3\x96\xd0V\xae'w\xd3\xa99\xa9\x12\xa9*\x10V\x9c\xcf\xa02\xbd\nyZ\xa5\xf2g*\xb65\x7fYW\x8c\x10\xda\xea\x03f\xac\xd9\xe9s\xd0\n?3.\xd6{Q\xff\xbe\xec\xfb\x0f\x8cv\xedn\xcb\x0ey%\xcb\xef\x06\xf37\xe2\xa9\x8d\x06\x9a\x07.\x1a\x0f\x8d\x01&\x80\x1a:v\xe0\xd7\xbf\xd6\xad\xbff\x03\x03T3`\xf9\xc5\x95\xd76\xda\xd7\x9e\xb9\xdel1\xa9Y/'\xbd|\xad\xe9D\xbev\xd4\x81\x0d4\x05Uc\xe8\x9a\xad\x8e\xb0\xe1\x18#\x9f p\xd3\x1d\xd0B\xd9Ww@+\xe3S\xbcgZ\xc5\x82\xd6X\xb2g\n\xa9\xae\xf1\xd6\xfaq\xe4\xb9\xadT&\xf0.\x7fw\x7fR\xe1\x8a\xed3\xeb\xd9\x86\xd5L\xbeB\xed\xe6\x9b\xbd\x94\xbc\xed\x7f[\xea#\xbaix&\x92w\xd6e\xbff\xd95\xaadS#\x00\xaa3\xdf+\xf8\x1c\xf2H.\xb0\x04\x10a\xcfP3\xac\xadYK'V\x82\x01g\xb7\xce:\xeb\xcd\xcd\x0d\x0c 7:W\xc4\xd1\xe0\x0e\xc4\x11E\xa2\xefp;\xa2\xb1\xad9\x96.\xe2\xff\x8b\x04	{\xce\xd5\xce\xf1\x01b\xc2\xc6$o\xee\xaeF\xfb\x85\xc2\xe6\x0c\x01\xfd\xfc,\x05\xc5\x02\xe5\x0d%l\xdf\x0cn\x15\x9b\xff\xc0\xec\xcc\xda\\\x9bCl\x17!\x11T\xe3\x0d\xad\x136	@\x8d\x0diph\xe3RZ\xc7\xd6,VA.\x009L\x99\xdd<\xa1\xc2\xff\xaa\x009\xc1\x92v\xac|\xb2n\xb9\x17=\x17\xeb\x8e3\x95\x8e\xd1\xefX\xd3q!q+c\x03p>\xb0\x84\xcc\xf2\x826:\xd8D\xa66\xc4\xb0\xa5e\x9f\xebJ\x87\xd0\xbe\x14\xacS\xc1H\xc7\xfe \x1d\xc3ll*\x9c\xa4o\x87\x0e6X\xfc\x14\x15\xb4I\xf3\xfa[Y\xe3\xbe\xff{\"\x80\xa6V\xa3j6\xe8\xc6\x9e\x1e\x04\xf4\xa5\x0e\xe8\xfa\xc6\x8b\xcbr\xd3P\x90\xf9\xa9C\xa3.\xcc\x0e\xab\xc5U\xa4\xcbbnr(8\xd5\x0cj\xb0\x12\xf0\x14>)Z\xed\xe6\xbdI\x88\x8f\x1bA\xf1\x13\x80XG\xc0\x11\xbe <\xaeR\xd5\x17\xb4%\x05D\xb4J\x13\xd5g\xb5\xda^\xdd^\x05\x164$E%\xdb\x96\x89^F2\xa9\x03\x84F\x07d\x9a\x86O \xdbi\x92Ox|T\xcd\xa3\\\\\x9cP\xddy\x05\x04\xf8G,\"}\xea\xda4\xd4^X\xd0\xfd`\x92\x8a-XX\xdb\xed\xa5M4\x8f\xaa\xf0R\xd4|\xb1\xa4s_M\x83\x10~\x0b\xd06E'H\x87H\xd3\x8b\xce<\x03\xc7\x99\xe8\xf0\xb9^\x04\"\xfd\xa46\xf5\x08\xb7\x04}Qq\xc9	\xa7\x83T\x9e\xf4XW\xbe\xd8\x885\x9b\xcd\xceG\xacE\x0c\xfb\xed\x18\xf6\x85\x96\x13&\xab\xc2\x8a\xa9Bf\xde\xbf6\x1b\x1e\xd7V\xc5	\xf7\xf9\x9aYh\x1d\xa6QS\x85T\xad\x99\xe7\x1f\xcc\x7f\xac\xc1;\xda\x7f\xe8+L\xf8K\xbec\xdb\xef\x90\xa0\x1d\xc5rr@\x92w\xa3\xb6\x014b\x84\x90\xe1\xb1\xf6\x10\xd8\xa5\xcd\xd5wxn\xc0?\xb3\xc09\xa9}|[\xaa\xef\x00\xf3|>\xbfO\x94\x96\x89\x12pf:\x0b\x0f\xc3\x96\xd5\x14\xd4\x0d\xbf\x86\x90B\x12\x0b\x8as\xf7\xcb\xff#\xac\xff\xa19\xc0\x18O\x1b\x1eW\xc66{Z\xd3R\xe6\xe6\x7fo\xd6K\x11\xb5\x8b\x85O\xbb\xff\xd3j\xd0\xad\xa0\xb6\xd2r/\x04mK=\xaa\x01\x83\x02[?zc.+Z>m\xf8!\x91\xcd}\x912\x1f\xd5HE~\xa3\xaa\x03\x07\xe6\xd2\x80\x87`\xf6*\\\xda6|\x04&\x8c\xffvLLx-+\xceJ:\xaa\xe9O\xc7R\xdfO\x9e\xe4:)\xf2\xc5\x826\x9a\xf3l\x01\x13\xf6\xda4\x05\x0b\x98\xb1\xa7	\x93\x04\xe5R\"&\x14>&\\\xafuJ\x9f\x94\x15\xab\xc9\x1ftS\xf4\x0d:\xc3\xde\xbc\xc7[,\x18\x1a\xf9B\x98\xba\x92\xb5\xc1`\xa9@]\xbaK\x1f:\x82\xd9\"<X\xfa\xa9\xd9\x1aw\x13\x8b\x10\x94S\x8f\xcd\xe6\x1a\x8b\x1d\x1d\x1eF>\x1e=\xf5}\x8a\x0f\xa7^0\xab\xccE\xbe\xb0U\xac_2\xf0\xf7s\x96x\x89gcW\xcc\xdc\n\x93\xe2?\x0b\xc1\x85\xcb\xeb\x97T]=6\xb4\xef\xb1\xd9tv\xe4h+FA\xc9X\x01\xda\xec\x82\x02\x11V\x8c\x82Ec'\x1d\x02n\xba\x13\xe3\xd0\x08\xc0P\xccG\xc9d\x90\xc3>=L\xa7\xba\x88\x80\xf6\xb2\x00\xe1\xf2\x16\xd8:\xe8K\xc6\x94U\x85\x03\x08\x8f\xa8N\xe3z0\xbf\xb3\xe0&\x08\xa1^\n\xde\xee\xb2\xe4\x80\xee\xe1\xe1\xd3\xc3\xa7\x07\x9fy\x0c\xa40\xd5F\xbdZ\xae\xe9\x9f\xa3\x15\xba\xc7\xc9@\xfe\xf9\xfb\x8f?|\xfcx&V\xfb\x05\xf6\x98s\xef\xa7\x81(\xa0q\x85ZH\xd6\xe2\xdd }\xa2\xcb5g\x87(w\x87d\x8d\x08p_\x04u\xb8\xa0\xff\xd83A\xc9 \x8d\xa5\xa9\x9bV[\xa0\x8e\xe8y\x97\xf8q\xcf\x08UQ\xd4T\xbe\xdaC\xd0\x1f\xd9\xae\xaa\xf5\x84\xc1\xb8\x8a;|\xe5\xee\x1bvcml\xb7\xdb\xdb\x12\x00\x96\xef<\xf9\x91\x83-\xd479\xd6\xa7K\xf5\x8d'\x01\xa0\xbb0\xa1_'\xe2D\x1a\x185.v\xde\xe3M\xec\xae;\xe8~$\x1e\x0b\xe2M\xcf\xeb\xbd\xd4\xd3_\xd5[\xa5\xe7nn\x803S\\~\xd1#\xe2\x83\x92#}l\xe4\x03\x1bT\xf2\"\x98\xe8@\\c\xc8\x80\x00\xbe\x0f\x02C\xcbJuIo\xdc\x93\xb7\xfc\x11\x08\x16m\x82\xadtl\xc8\xd1N02\xf4\xfdnj\xdd\xd0\x87\xab\xa5\xe3\x06\xda\x1a!\xfay_\x96\xb4\xef\xd1\x9fL\x00qSl=4\xe8\xed\xb3\xc1\x7f\x90u \xab\xe0\xa0\x16D+\xfd2\xa1\x93\xa8\xb0\x03{\x10`\x91\x89\xb0\x99\x1fH8\xda\xd5\x0c\x1d3\x84\x10\x82\xa3\x0eu\x1dO;\xfc=\xebJ-\x17\x0d\xae\xcd}go\x9d\xd4\xfc\xe7\xf0\x80\x7f\xc6}\xff\xc2\x05\xb1'\xdb\xd7yg\xef\x1c\xc1^\x9d\x16\x96Zlp\xd3\xbd\xa3\x19\x1e\x8c\x8f\xa48y\x92\xa8\x9a\xd9\xd3\x84\x19\"\x16~\xc3k2\x12]\xbf\xe7\xf2\xbd\xfa@sh#\x0dT\x00\x99\xdb\xa8:A\x08\x19\xab\x98\xdf9\x91\xbf%\xb1\x0e\xa7\x9anT\xf0\x85\x9c\xe2:\x08!\x14\x8c`\x1c\xbb\xf1)U\x92\xaa\xd9c\x89;YV\xf8\x08wjs\xf41\x15.\xd33\xcac\x18I\x8dF\xd1\xe7fC	\xa1\xc4\xbc}Q:\xcdr\xaa\xee\xa1\xe8M\xe60*@\x9e\x80[)y\xf7\x1e\xb9\x0b\xa3f\x7f]\x15\xc0%\xc7[GL\x0c\xe2h\x98\xff\xfa\x85\\\xea\x05P\x05v\x96\x17\"`3\x8f%\xe4\xaa\x8f\x90Nn=\xe6\x98\xf2+\xb8~{\xe2\x85\x9f\xf1N\xd3\xbfi\xb8\x8d\x7f|\xc45m	\x16\xe6\xcd\xde\xe8\xf3!#\xec9/\xdd\xa2#\xf2\x11\x1b\xf979H\x0f\xbd\xdd\x12\x8975E\xc7,\x9c\xea\xdeg'^!\x0f6\x86\n]M\x1b\xd7A\xa6\x08\x02Y\x0b\x99\xba\xf0\xdfn\x92\x03a\xed\xd8\n\x1dQ\x96@U9h\xe6\xe3\xdb\xac;\xe8;\xe6\x13\xd1i\xf13:f\xa9$\x1b\x82SQLP\xae+I\xc5\x14\xba\x0b\xd2\xfeb\xa9\xa7t\x1c\x80\xe1\xde\xb4\x06pAYM\xd3\xe7Z\x9dX\x02\xf5S\xf0\x17%C\x88\xb1\x95\xda\x91\xd5\xf9?\xe9\xd4#\x92\x04\xbf\xb6\xb8\xa1\xfd\x98\xa6\xd2\x1b\x9c/\xbc\x8d\x9e\"\xa6i\x9d~a\xae-(PP\x12\xb8\x00\xa3\x14\xab\x17J\x9fhK\x147\xb7v\xb9\\\x9e@New\x1a\x1er\x12\xc0\xe5.\xf4\xb9\xbdt\xaeU\x1c\x11\xc4\xa5\xf2\xa2\x13\x04I1\xdb\x16+\x0f_Hr\xe6\\\xda\xbcj5Q\x14\xd0\xdfp\xf2\xaa\xf5\xa2\x88\xdb\xee\xcft\x0e\x8e\xbc\x1e\x9bB\xc8\xec\xa4#`\x01\xfe\xe9\xdc\x91`\x90sYQ\xd1\xf0VV\xe8\x08}Z\xd7\x8b\x8e\xdbf\xb3y\x03\x81\x9c{u\x0cP\x9a\x9d\xa30\x93\xbf\xb4\xe8xF\xda\xa0\xd9@ \xf6\x8c\xa3KB\xe1F:\xc1_\xac\xce%	}\x1c]><<\x14\xdf?|{\x9f\x91\xf3\xad[%\x01V\x06\x0d\x08\xfe\x0b\xc4W\x86\x17\xe4\xe7\xc1^F\x05\xe5\x98\xd9`\x81\xd0\xcc\x02i\x17\xb3\xd5\xac\x08Y\x8cP\x87f\x98\xe0b\xc6\x11\x94\xa4\xe3\x9e\xcb\x0fPIwww\xf7o;\xbf\x0b\\	\x0c\xb4\x91\xbf\x01\x84\xb7\xc5\x00\xb7Zr\xebV\x89\x93\xb8\xed\x06\xb3PO\x9fV\x9f\x1f>\xce\xd2\x06CX\xaf\x8cZ\x89\xe0H\xacV\xab8\xbd\x9a\xb5\xb4\xe9\xe4kI\xebZ\xc9\x00\x9a\x0e\x04\xffD!\xb9\xc9F\xfdD\x813,W%\x07\xca\xd5O\xbb\xfa\x9b\xe9h\xb1XF\x81/EQJ\xd6\x8d\xd2\x83\x8eA\xc1\xd6\xb8'\x07\xd3[h\x1e.\x05z\x0b\x99\x8f\x8d\xcf\xf0M\xc5\xec5\xba\xc4x\x9b\xb4\xbb\xe9\xcd\xf6TbMDq\xc3\"\x15\xc5\xd7\xe8\xf2\xf6\xb6\xfcF\xc0\x06ED\xc9\x9b\x0dW\xf1\xcc\xd7XC[\x1c\xe9Kc\xa1\xc11%\xa4\xfe\xd5\x16\x90z\x14\x9b8\x96\xce\x96g\xb3\xbdV\x05\x88\xdez4\xeb\xfalU\xe1\xa6%\xceu\x13\xf1\xfe\xd4\x83\xc9O\x9f\xb7\xec\x80\x8eI+:\x83l\x9a\x08<dr\xb9SvxT\x90\x99\x13\xf6\xe9\xf6:-\x9e29\xfd\xe7y\x89\x82$x\x9c*\xd3\x12a\xe2k\x96\xfd3\x00\x00\xff\xffPK\x07\x086\xe9[\xc4\x8d\n\x00\x00\x16*\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00CbfQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x07\x00	\x00view.jsUT\x05\x00\x01\xfe>\xa5_\x84U\xebr\xdb\xb6\x12~\x15\x1d\xcci\xb8\x88`\xd8\x90l]\xc2\xa0\x19\xdbq\x13\xa7\x8e\xd3\xdc\x9a\xa4\x92\xc2\x81HP\x84E\x91\x0c	YV\xb8|\xf7\x0e)\xc9\xb2;\xd3\xe9?\x00\xdf\xe2\xbb\xecr\x08}\xabb\x08\x97\x89oM\x9a@\xc6\x14\xf3\xd9\x9ci\x96\xd3R\xcb\xfbs\x9f\x96\xb9\xb6\xcb<\x01\xff\xb9z\xe18\xcf4d*/\xf4eb\xc1?T\x94\xd26\x80/\xfd_\x14\xfd\xb5{\xf2\xe2\xa3\xcdM2\xe3a\x9e.\xce#\x95\x9f\xa7\x81\x06\xbf\xdd\x19\xd2g>\xb7\xe9\x06\x85n\x8f\xd2\xca5!\xfc\xcfqx\xae\xb3X\xf9\x1a\x0e\xbf\x1f\xb2\x0dNi\xb9\x8aL\xac\xc1?8\xa0\xf9H\x83O'r>\xf2'\x88\xf5\xda\x9d\xcb\xd1\xbd=\xbd\xb3\xd7\xcaGzRM\xdc\x07\xd6w\x903\x1e\xaf\xdaN\xe5\xfaRT\xee\x9e\xd9\x84P\x93\xd2Lf\xf7&\x12\xbdj}\xd0\xb3\x8b\xbb\x0c\x9c\xf1x\xea\xb4k\xc1v\xb3d\xce\xcc\xa1\xac\xb9\xe1n%\xb3\n\x9c.\xf4\xf9WZ\xf6G\xe4\x03i\xab\x89Tn\x7fD~6\xcb\x1e\xec\xcf\xa1\xcf\xc5\x9cVn\x9f\x7f\x05\"4a\xbb*Z]\x94}.\x86@~\x12\xa6\x988\xa1U\xa7u#_\xb7\xfe\x04\xea\xf6Z\nh\xd9ii\xf9\x83\x8b\x00\x88P\x84\xba\xdd:t\n\x9a\x91?\x08u;\xadky\x06?\x18yJ\x18\x11\xc7\x0d\x0e\x9a\x8b\xces)\x8e(\"\\KI\x08\xa5\xa5\xbf\xb9\xc1\x02ZU\xc7\x0f\xae$\x84\xbas0\xf2\xc85\xcf\x8f\xf9\xd45\xed6-\xbbp<2\x13\xfe\x9b\x94D\xcc\x08\xe2~\x17>\xda\xf9\x84\x96\xcdNL\x9b\xb89P\xd7\x87[~\xc2O\x18	\x1b1\xb7\xc1\xdf\xfd+|\xc3_\xc2\x0d\x9f\xb2#V\x17\xd6\xed\xf8\x8f\x0b\x1b\xbdA\x83\xa7{\x8cVU\xd5i\x9dK\xd1\xe7\xa2\xc7E\x17\xea\xde\x9c\xca\x1f\xfc-\x10!\x9a\xbe\x9c\xf3\xdf\x81\xbc!\xb4-\xeav\x9c\x8e\x8e&\x8c\xbciH\xb7\xd8\xe5c\xecr\xd3\xad^+\x07Z\xce\xa1\xd3\xdat\xe9f\xd7\xa5\x14nj/{\x03\xbd\xd6\x19,\xd8\x9a\xad\xea\xa1\xddIXKI\x9e\x92'O\x16\xfc\x1b}\xb1\xe0\xdf\x9e-\xf8[X\xd7\xc6^m'\xbc\x92+.\x16p8\x1e\x1f\x1c\xce\x18\x19\x8f\xc7\xe3\x83f\xaaW\xf2uK\xc4@\xe0;\xd6\x87\x05%\xedU\x9b@\xb3\xc6\xff\xd3\xa6&q\xf7\xa6\xeev\xa6\x12y72\x13\xb7\x0bW\\\xdc@\xc2\x07\x94\x96\xaf\xb80\x90\xd0\xaa\x12\x11\xbc\xa2U\xaf\x95B\xc6>\xd5c\xce\xf8\xa0\xf6\x1a\xc9\x8c\x0f\xf8_@Z\x0d\xf1g\xf9\x89[\xa0\x0f\xe8\xa3\xfd\x97\x11\xd5\x99-P)?\xd32\xe2/\xc10A]spPU\x19\x1f\xc8\x88\x7flh\xean\xf8\x10\xb3%{_\xdf\x8a7BC\x19\xef\x85\xba\xf0\xbe>\xfb\"\x97\xffP\x1b\xee\xd5\x86\xf7j_h9|\xa4V\x0dGC>\x9d\xc8\xa5\x1b\xf3\x81\x1c\xeet/\xcaz\xbb\xac*\x87\xf5:l\xd0c\x0e\xe2\xad\xca\xd1\x84\xa8c\xbd\xd0\x89-0S\xb9N\xecu\x1ah\xdc\xfd.pe\x92 ]\xa1\x1f\xab\xa2\xb8V\x0b\x8d\x9e\xe8\xa1I\x8c5*6?5\xc6:\x99\xd9\x08U\x10\x9c\xdf\x97\xd8|Y\xd7adfQlf\x91\xd5\x01\xa2'\x8e\x10u\xec\xa9<Wk\x0c\xd3\x1c=\xd1E\xaf\x87\x9e\x8f\xb9^\xa4\xb7zO\xe0i\x0cR\x7fY\xbb\xc2B\x85*7^\xae\x0bm\x11m\xfa9\xcbt~\xae\x8aZ\xe1\x18md\n\xf4\x06\xe8\x0d\xd1\xebc\x9c\xaa\x00\xbdc\x9ci{\xb1\x0du\xb6~@\xdb\xc5\"\x8b\x8d\xafQ\xc7\x85F\xbb\xce4z\n\x13\xbd\xc2\xd0\xe4:L\xef\xb6jh\x92@\xdf\xbd\x0b\xd1\x9b>\xe6\xfa\xa4f\x1b\xa6\x0e\xa6I\x98\xfa\xcb\x02\x93\xd4\x9b-M\xa0c\x93\xe8\x02=q\x82\xfaV'\xd6k\xbc\xdc\xa4&A/DO\x08<m\x82{\xa2\x8f\xcaZ\xe5G\x17u\x19\xaa8nLY<>9\xc2i\x1a\xac1\x0d\xc3B\xdb/&\xb0\x11\xda\xf4*]m\xf3>\x90	U\x1d`Y\xe8\xfctV\xb3$\xea\xd6\xcc\x94MsL\x93i\xbc\xcc\xeby4\xfcW\xa6\xb0:\xd19.\x94I\xbc\x0d}\xe2\xc7\xc6\x9fchb\xfd \xdd\xd9\xfa2\xc04il\xe7*0)\xfa\x91\xf6\xe7\xd3\xf4\x0e7?v\xcc\x96E\x84V\x17v\x93\x107O\x02n_\x89\xddg\xe4\xf0&\x0e8\xe8Pv\xc4\xca\x8a\xd2\xbf\x03\x00\x00\xff\xffPK\x07\x08\x92\xfbm\xc8Z\x04\x00\x00T\x07\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00AbfQ\xde7\xe4\xf05\x00\x00\x001\x00\x00\x00	\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb6\x81\x00\x00\x00\x00blank.gifUT\x05\x00\x01\xfb>\xa5_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00CbfQkI\xa2e\xb9\x01\x00\x00\xaf\x01\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb6\x81u\x00\x00\x00bottom.pngUT\x05\x00\x01\xfe>\xa5_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00CbfQ#{\xea}\x9a\x03\x00\x00\x0c\x07\x00\x00\x0c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb6\x81o\x02\x00\x00iepngfix.htcUT\x05\x00\x01\xfe>\xa5_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00o\x1agQLF\x14QN\x04\x00\x00\x06\x0e\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb6\x81L\x06\x00\x00index.htmlUT\x05\x00\x01B\x12\xa6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x16\x1cgQ4\xe7\xb3\xaa4\x02\x00\x00\xf6\x04\x00\x00	\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xdb\n\x00\x00plot.htmlUT\x05\x00\x01]\x15\xa6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00CbfQ\xc3\x86\xc1\xa33\x00\x00\x00.\x00\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb6\x81O\x0d\x00\x00shadow.gifUT\x05\x00\x01\xfe>\xa5_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00CbfQQ\xf1\xa7\xdc\xab\x01\x00\x00\xa1\x01\x00\x00\x07\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb6\x81\xc3\x0d\x00\x00top.pngUT\x05\x00\x01\xfe>\xa5_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00CbfQ6\xe9[\xc4\x8d\n\x00\x00\x16*\x00\x00\x08\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb6\x81\xac\x0f\x00\x00view.cssUT\x05\x00\x01\xfe>\xa5_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00CbfQ\x92\xfbm\xc8Z\x04\x00\x00T\x07\x00\x00\x07\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb6\x81x\x1a\x00\x00view.jsUT\x05\x00\x01\xfe>\xa5_PK\x05\x06\x00\x00\x00\x00	\x00	\x00A\x02\x00\x00\x10\x1f\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00#\x8egQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00	\x00	\x00blank.gifUT\x05\x00\x01\x13\xde\xa6_\x001\x00\xce\xffGIF89a\x01\x00\x01\x00\x91\xff\x00\xff\xff\xff\x00\x00\x00\xc0\xc0\xc0\x00\x00\x00!\xf9\x04\x01\x00\x00\x02\x00,\x00\x00\x00\x00\x01\x00\x01\x00\x00\x02\x02T\x01\x00;\x03\x00PK\x07\x08\xde7\xe4\xf08\x00\x00\x001\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00#\x8egQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00bottom.pngUT\x05\x00\x01\x13\xde\xa6_\x00\xaf\x01P\xfe\x89PNG\x0d\n\x1a\n\x00\x00\x00\x0dIHDR\x00\x00\x03\x02\x00\x00\x00\n\x08\x06\x00\x00\x00\x07\x07\xe9H\x00\x00\x00\x04gAMA\x00\x00\xd6\xd8\xd4OX2\x00\x00\x00\x19tEXtSoftware\x00Adobe ImageReadyq\xc9e<\x00\x00\x01AIDATx\xda\xec\xddQO\x830\x18\x05\xd02\xeb\xfc\xff?W\x19TH\xda\xe4\xb3\x96e>\x0cM<'\xb9\x01\x06|\xcf\xbd\x1bdS)%M\x9b\x94\xd2\x9e\xcb\x96\x97\x9a\xbc\xe5\xb5\xe6\xba\xe5\xadn\xaf\xe1\\\xbb\xf6R\xef\xdf\x03\x00\x00<G\xa9Y\xb7,5\xf3\x96\xdb\x96\x8f\x9a\xf7\xba\x9d\xc3\xb9v\xed~_\xd9\xe5\xc1\xe0\xb5.\xe8\x97\xc1\xe2~\xad\x83rW\x02.\xf5\xbc\"\x00\x00\x00\xcf-\x02m]\x1e\xcb\xc0\xadf\xae%\xe0\x16\n@\xbb\xb6\xc4A\xb9\x1b8*\x03\xb1y,\xa1\x00\xc4\x120)\x02\x00\x00pZ\x11(\x832\x10\x0b\xc1\xa8\x04\xc4\xa4|0\xbc\x0d\xee\x8f\x97A\x01\xf0k\x00\x00\x00\x9c_\x06\xd6;\x85`T\x02\xbe\xc8w\x86\xa6\xae5\xb4\xe1\xb1\x04\xc4\x00\x00\x00\xe7\x95\x81~\xad>J9*\x03\xb9\x1b6\x85\xfd\xf6xP\xe9\x8e\xfb\x17\x83c	P\x08\x00\x00\xe0\xb9\x05\xa0\xdf/\x83\x05\x7f\xff8\xd0\xb7\xfb\xf3\x03\xc3\xa7\xb0=z\x89X	\x00\x00\x80\xf3\xcb@\xea\x16\xfbeP\x00\xcah\xc8\xd1\xa3A\xd3As\xf0\xed?\x00\x00\xfc\xddb\xf0\xc8\xfe\xb0\x08\xa4{\xad\xe1\xe0s\x85\x00\x00\x00~\xb7\x00\xfc\xf8\xfc\xb4\xff\xa1\x18\x00\x00\xf0\xbf|\n0\x00\x94%\x99\x85X\x0c\x06\x0c\x00\x00\x00\x00IEND\xaeB`\x82\x03\x00PK\x07\x08kI\xa2e\xb6\x01\x00\x00\xaf\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00#\x8egQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0c\x00	\x00iepngfix.htcUT\x05\x00\x01\x13\xde\xa6_\xbcUQo\xe36\x13|\xe7\xaf\x983\x0e\x91\xf4\xd9G9\xc1\xe5+\x10\xdb)\x82k\x12\x18Mr\x87\xbb\x14-\x90\xa4\x00M\xad$6\x12)\x90\x94\xed4\xce\x7f/(\xd9\x8d\x03\xe4\xb9\x80\x1f\xac]\xee\xec\xce\xccR\x9a6\xed\xa2R\xf2D\x9a\xba1\x9a\xb4?e\xbb\x90\xf0^\xc8\x12\xb4$\xedg\x03\xa3\x1bk\x1a\xb2\xfeI\x96B\x174\x80\xd1\xdbTf.\xd4:N\x06HO\x19\x9b:iU\xe3\xe1\x9f\x1a\x9a\x0d<\xad}\xfa\x97X\x8a>:8e,M1??\xe6\xc7C|\xbb\xb9\xc4Y\xd5\x94\x02\x17j\x8d\xe5!\x1f\x7f\xff\xf29\xe4c\x99\xe0h<\xfe\xfc\xe9h<>\xc6\x99.Z\x87\xdb\xd6\xeaE[U(\xbdoN\xd2t\xb5Zq\xbfR\xba\xa4J\xad\xb94u\x87|[*\x07\xe5P)I\xdaQ\x86Vgd\xe1K\xc2\x97/\x9f.o~\xc3\xd5\xe5\xb7\xab\x11\x96d\x9d2\x1aG\xfc\x10\xc6\xa2\x12\x9e,\x0f\x00\x17\xc6\"#/T\xe5FpD'\xbb~\xd2\x92\xf0jI\xd2\xd4\xb5\xd1\x8e\x1b[\xa4\xdb..\x0d\xa0\xe9\x11?L\xd9\xeb\x14u\xeb<\x16\x04\x81F\xf8\x12\xde@`Q	\xfd\x08U\x8b\x828nK\xe1#\x07QU\xdd|\xd2\xe8\\\x15\xad\x15>\x0c\xf6dZh\xa2\x8c3\x95#\x0eb\x9a\xbc/\x9f\xd7\x05f3D\x81Y\xae4eQ\x82\xa5\xb0{ID\xdd\x7f^\xa8<\x9a0\xc6B6\x0f\xe1_\xfe\x98\x87\xd6\xb7Vh\x97\x1b[\xf3k%\xadq&\xf7\xbc\xf3\xa1\xcb^\x19\x91\x91\x0d\x85y\xabe7L\xae*\x1f\xbb\x11\xea\x84=3\x84\x81B\x84\xac\xbb\xcb\x1f\x12\x86g\x06\xbc\x068i\xb1\xa8(\xc3\x0c\x0e?\xc3\xdb\x96p\x82\\T\x8e&\x0c]\xb5K\xb0R\xbe|\x03\x83g8+C\xd1\x04N\xfd\xadtqM\xbe4\x01\xa6\xc6\x0b\x0b?\xaa\x1c\xed\xea\x9d\x7f\xaa\x88\xf7]\x03\xb5\xc6\x9aBe'\xd10\x1fF\xb1\xb3r6\x88\x86n\x18\x0dF\xfbX!X\x0f\xa3A\x12M\xd8\xcb\x1e\xbf\xed\x02\x07ri\x8a3\xe7\xda\x9a0?\xff)l\xd2\xd7_yO\xf9Cz\xfdc~\x8e\xf8\xf8\x9e\x1fo\xfe\x7f\xcf\x93\x94{r>\xd6b\xa9\n\xe1\x8d\xe5\xad#{V\x90\xf6	6\x1b\x06\xc4\xdd\xfd\xc0\xc1\x01>\xa4\xf1B\xc8\xc7\xc2\x9aVg\x1bg\xe5\xae\xb8;\xc1w7\xebF\xd4\x94$	,\xf9\xd6\xea	c\xbd\xb1E\xef\xaal\xad%\xed\x7ft\xcc_\xe1:\xd3\xb0\xd9\xc0\xbd\x97\x08 A2/\x8a\x80\xdem\xce\xfc\xfa2\xda\xda\x16Rqz\xcf\x1b]|LU\xd23\n\xf3%\x0c\x9d\xaf\xdd\x897\x9dW*\xf3e\x87#Zo\xa2@\xefM\xbe$U\x94\xfe\xf5@@\x02\xdc~-L\x9e;\xf2\xbfwOCD\xcd:\n\xab\xb1\xdd3+G\x88\x9c\x14\x15EI\x17\xee\xf7b\xb7\xdf!\xf4\xc2\xb0\xb7\x0dVr\xa53Z\x7f\xcd\xe3\xdd\xa1\x04S\x8c\x93\x1e0\x99\xbc\xdd\x9e^\xcf\x83\x03\xf4\x7f>\xcc\x10i\xa3i_\x92.\xc3k\xe1e\x19\xa7\x7f\xb6\xb6\xba\x8b\x07\xd1\xc30\xe6\xff\xeb\xa4J\xee\x92\xf0\x18\x14\xfbW\xa7\xe0\x94\xc3\x0c\xdf\xa98_7\xfc\xe3\xe1\xe4\xbfV\xef]\xff\xb1c\xb7'\xf0\x08\x91\xb4\xa6\xd9\xaa\xdb\xbd\x8fQ)\xfd\x88\\\xady\x08\xe5\xc6\"\x0e\x844f\x18O\xa01\x85,U\x95\xdd\x98\x8c\x1c\xafH\x17\xbe\x9c@\x0f\x87\xfdx\xc1\x85\xd7\xfc\x9d~\xe0\xdd,	\xde	\xf2\xc68\xd5\xdd\xba\x19\"KU\xf7R\x8d\xde\x98\xba\xe7\xda\x0bc\xdb\xcb9al\x9a\xf6_\x91S6M\xb7\xdf(i\xea\xc6h\xd2\xfe\xf4\x9f\x01\x00PK\x07\x08\xca\x93\x8a?\x93\x03\x00\x00\xc9\x06\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xa5]R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00index.htmlUT\x05\x00\x01G\xb1\xd4j\xbc[\xdds\xdb8\x92\x7f\x96\xff\x8a>>\\l\x0f\xf5\xe9x\x12\xdb\x12\xabnc'\x97\xbaL\xd6\x95xvg_\xce\x05\x91\x10\x891\x080\x00(\x93\xfb\xd7o5\x00R\xa2b\x8f)\xe7\xe3\xc5\"i\xa0\x1b\xfd\x81\xee\xc6\x8f\xcd\xf9\x7f]\xfe\xfd\xcd\xcd\xbf\xae\xaf 39\x87\xeb\xdf\xff\xf6\xe1\xfd\x1b\x08\x86\xe3\xf1?O\xde\x8c\xc7\x977\x97\xf0\xc7\xff\xde\xfc\xf6\x01\xa6\xa3	\xdc(\"43L\n\xc2\xc7\xe3\xab\x8f\x01\x04\x991\xc5\xf9x|\x7f\x7f?\xba?\x19I\x95\x8eo>\x8d+\xa45\xc5\xc9\xferh\xb6f\x8e\x12\x93\x04\xd1\xc1\x1c\x07A\x95s\xa1\x17\x0f\x90\x99\x9e\x9d\x9d\xb9\xd9v,%It0\x98\xe7\xd4\x10\xc0\xc1C\xfa\xa5d\xebE\xf0F\nC\x85\x19\xde\xd4\x05\x0d vw\x8b\xc0\xd0\xca\x8c\x91\xc1\x05\xc4\x19Q\x9a\x9a\xc5\xef7o\x87\xaf\x03$b\x98\xe14\xba\xbcz#\xf3kEb3\x1f\xbb'\x07\x839g\xe2\x0e\x14\xe5\x8b@\x9b\x9aS\x9dQj\x020uA=\xcdX\xeb\x002EW\x8b`\xcd\xe8\xfd\xc8\xde\xe74ad\x11\x10\x8ek\x1d\xccu\xacXa\xb6g\xfdI\xd6\xc4=\x0d@\xab\xd8\xcf\xfdS\x07\xd1|\xec\x9eG\x07\x07\xf3\xb1\x93r\xbe\x94I\x0d,Y\x049a\xe2\x16\xef\x02\x88\x0e\x0e\x06s\x96\xa7\xf6\xb9\x91\x85\xa7cd1*D\x1a\x00\xe1f\x11X\xee	[\xdbA+\xa9\xf2[T\x08a\x82\xaa\x00	\x0c\xe6\xd94\x9a\x93\x8e\xe8$\x9a\x8f\xb3it0\x18\xccq\xc6f\xea\xe9\xe9\xe4u\x001'Z/\x02R\x14\x82\x19%\x03\x80\x9c\x9aL&\x8b\xa0\x90\xda\x04@bt\x87E0F\xde\x03\xc7\xdd\xcfAr\xb7	u\xf21)\xdc\x88\xc1<\x9bu\x16\x90\xcd\xfc\xf3\"\xbad\xab\x15UT\x18F8\\})	N\xd3\xf0F\xe6Ei\xec\x0d\xe1`\xa7\xb1\xb8\xccC\xf8\x17\xe5:#\x06.K}G8\xab\xe9:\x84\xbfM\xcf\x86\x93\x97\xf3q\xd1R}\x0f\x87_\x0f<\x82\x8c\xac)\xa4\xd2\x00\x11\xf0\xdad\xb0&\x8a\x11aB\xb8\xcfX\x9c\x01\xd3\xe7\xdbDV\x87UX\x1f\xc1\x02\xea\xe3\xfa\x98V\xc5au\x04C\x98\x1d\xd7\xdb\x83\xea\xc3*\x8cq\x10\x0e\x18VG0\x86\xc3\xb8\x19\xfd\x0bL\x8f\xb6\x07\xbf9\xac&!\xd4\x13\x1c\x7f\x88c\x86\xd5\x04i\xe2\x931\x1c\xd6\x138\xb6t\xaa\xc9Qg^=\xd7\xe52\x9a\xcc\xc7\xf8\xb3\x98n\xff\xab\xea\xfck\xd8\xd1\xc2\x1f\xb0\x80\xce\x83\x1b	Z\xf25\x05\x93Q\xd0\xb564\x07\xb9\x02\xda\xa8=\x04M\x0b\xa2\x88q#\x14K33\xcc\x88H@\xb3\x84jXYfS\xc7\xec\xc2\xdf\xce\x9a\xdb\xd1hd\xf5?\xc0	\xc8\x81	f\xcd\xba&\xbc\xa4\x1a:R\xc0=3\x19\xbc\xb8x\x11B,\xf3B\n*\x8c\xc6\xc5\xe0Dmp	DQPtE\x95\xa2	\x10\x0d\xf54\x84z\x16\"\x9f\xd01\xc2\xb1\xb4\"\xb1A\xb1J\x14\x01\x98\x06!\x0d(\x8c\x158o%\x95\x97T\x8f\x1eU\x85\x18\x9a\x0c\xa4J\xa8ju\x11\x02\x1d\xa5#\xa8_\xbc\x80_`2\x9a\x1d\xd7x\xa1\x998\xb4.1	a\xc58\xb7\xabm\xa6\xc0\x8aQ\x9e\x00\x11\x89[]\xa1\xe4\x9a%\x14\xc4W\x8a8\xecX\xed\xe8\x02\xea\x17_=\x1a\x8dF\xad5\x12X\xd6\xa8+`\xa2\xabE\xaf\x07i2\xaa`U\n\xbb3\xb5\xd5\xdc\xb6\x16:\x92\xbf_uW\x9d\x11T\x19\xc4\\j\xa7\xaf\xbc\xd5f\x08\x9c\xe2\x96\xf1N\x8ev}\xd3]h\xd8Y\xce\x11\xd0\xbc0\xf5\xb6u\x94\x92J\xc3=\xeajI!&<.\xb9\x95HQN\x0cC\x03H\xbb\x9e\x8c\xa5\xd9\x90\xc4q\xa9H\\\x83(s\xaaXL\xb8\xf3\x00*b\xda\xae\xaa#\xcdUU(\xaa\xb5\x0d\x1a\xba,\n\xa9\x0c\xfc\x02C8\x86\xb1]\xef\xff\xc3\xa1Tp||\x84~&\xb4!\xe8f\x05\xb3\xff\xa3\xe1\x96\xcehU\x84\xc0\x05\x1cr\x99\x1e\x85\xc0e:\x9d\x84\xa0\xbf(\xe3\xc5!K\xdc\x1f,\x15\xf8W 9\x1d\x82!\"\x04b\xef\x89}@\xec\x13\xcdDfGdvH\x16Z~\xf8\xbfY\x08\x85\xbc\x0f!\xc7)9\xa9\xac\xcb\xdfK *-s\xdc\x03\xadt\xf3q\xc2\xd6\xd6_\xe7%\x07\x1f\xda8\xb3\xd1\x9a\xb3\xdb\xe9$\xf0\x0f\x07sN\x96\x9471x;\xfc\xa2\xfb/\x82\xc6\xd4A\xf4\xa0\xa3\xcb\xc2\x87\xd9\xf9\xd8\x12j\xa86\xec\x91/\x13Ei,k\xca)\xae\xd3\xf2\x17$\xa7[\xe4\x9b\x15\xf8!\x80\x19\xd9&\xca2\xdfN\xa8\x01\xca\xcd\xa9HM\xb6\x08f\xa7\xa7\x81\x0b\x10\x8b \x187\xac[\xd1\x07\xf31g_\xc9\xfe\xaa\xb7\xe8\xab\xaa\x0e| \x0f\x81p-\xe1N\xc8{a\xc3\xc9\x8b\xfd\x04~\xd5\xc8\x8b4\x7f\x9a\xa8\xaf{\x8bZWq\xe0\xd3\xd1\xae\xa8\x0f\xc4\xc9\xbdD\x7f\xdd\x88\x8e<~\x9a\xe8g\xbdE\xf7K\xb9=\x0b\\\x8a\xad'[\xdb}\x93\xddAP\x9a\xf8\x9c\xf0-\xea8k\xd4\xf1\x13\x951\xed\xad\x8cj\x12D\xef}\xc2\xa9`'\xaf\xec'\xe8\xb4\x11\xb4\x9a\xfc8I;6\x9f\xf5\x16\xb3\xde\x12\xb3\x86\xc3\xfa\x1b\xc4\x9c5b\xd6?K\xcc\x93\xfe\xd6\xbc\xa5\"	\xa2+\x910\x91\xa2=\xff\xd8\xd3\x86'\xad\x0d-\xa5\x9f#\xdf\xcb\xde\xf2\x89 \xfaX\xe6K\xaa0\x11jC\x0b\x0d\x87\x1f\xf7\x14\xf1e#\xa2\xf8I\xe2\x9d\xf6\x17/g(\xa1u\xce\x9c	\xe7\x9e\xfbIw\xdaJ\x97\xb3\x9f%\xe0\xaf{\x08H\xaaV@R=G\xc0_7\x02\x92\xea\xc7	x\xb0\x9b_\xa6\xfdc\xaa;\x00\xeb \xfa\xcd]`v\xc9s2l\x0bt\x7f\\Pw/\xc3\x8c\x96\"\xa4%\xa7\xea\xd9\xa5U\x1bw\x1b\xbe?B)\x83y\xd1\x90MK\x96P\xce\x04\xd5\x81\xb5\x8c\xbdG\xfdDs\x9d\x13\xce\xa3\xffY\x13\xc6\xc9\x92S\x0f\x05\xe8s\xf0\x12\xb2\x1c\x8f94\x19\xfa\xfb\x9c%\x85d\x98u\xad\x1e\xbc\xd5\x15\xe1\xdaH\x11\x82\xba;\xc1?/\xf1\xcf\xc9\xeb\x10\x12Y(v\x1a\xc2\x92\xc4w\xf7D\xb5d\x8c\"\x05\xfd\xb7d	\xe1!,\x93\xd5,\x04\xb2\x9c\x0d\xc9\xf24\x04\x92\xcf\x86$\xc7\x8b%^-\xf3\xd3\x86\x8d!5\x97j\x8657^\x9c\xf8\xf3\xe8\xe0\xef\x82\xd7X\xe0s\x163\x03\x9fJ\x91\xd2\xe1\xff\x95\xc6\x90F\x9c\xf6\xe8\xe0\x8f\x8a\x9dS\xf1h>vZh*\xf3\xc7\xfc\xa9\x7f\xf2\x12R\xe5A\xf4\x11\xc1\x17\x7f\xe4M\xb9\\\x12\xde\x9c\x97\xb0Byws\xd5\xcf\x814\xe54\xeen\xa9i\x9b\xd2,\xa7]\xf7\xf13|\xd8h\x08\x0d\xe6\xce]\x1b\xa7\xb1\xbb\xd1\x0d\xa5\xc9\"h\xae\x82\x08\x8f-\x87\x1f\xfe\x9b\x89\x15\x13\x17G\xf3\xb1\x9b\xf6\x18\x19>\x0d\xa2\x84\xe9XQC\xe1\xc3\xf4\xc9\xe1\xb3\xed\xe1\xb3\xa7\x86\xab\\\x07\xd1\xa7\xdf>?5\x0es_D\x85sN\xa7\xe6\xdd)\xf3\xb1\x13\xb1w\xfc\xe8\x9f\xc5\x9b\xc3n\x10}\xf2W\x8d\xa5\xf7\x0b\x0cm2o	\xee\x9a6\xceh|\xb7\x94U\x93\x0c6\xf7\xde\x1cF\x95\xf4y\xe1\xe0\xa4\x0d\x07W\xceK\x11fH\x18b\x1c\x16\xa0@7&K[RS\xc7\xadqn[l{\xf7hN\xf1!\x94\x9a\xaeJ\x8e\n\xb2\x83\x9a\x7f\xe0\xa9\x1a\x11\xbe\x84\xc6\xa4\x06#\xc1\xf2\xf4\xd8I\xef\xbd\xd8\xbf\x02\xa1k<v\x07\xd1\x15\xfen\x01\x02\xa9?5\xee\x820\xcf\x8e\xeam\x99\xe29\xfe\x88L\xf7\xb4\x15_\xb6V\xbc\xc9(\xd8\xed\xa0\xf1\x88D\x15\xf5\"#|.R\xaa\x81\x19\xed\x01\x0f\xb44\x971\x027\xde\x8cR\xec\x1a\xcd\xe1e0\x84\xc9\xe8\xb45j.QvK\xbf;\x01b%\xb5\xa6\x1aG\xf76j\xff\xbaK#^\x1e}6\xb2\x00b,\xe3\x15S\xda\x80U\xfd\x9e\x9b\xae-\xc0,\xd1]\xab\xfd\xc0\x0dw\xda1\x15\x13\x86\xa6\x8a4('.\xa6\xa0IW<%\xa5\xc1MGD\xed\x05\xa2\x1d\xaf\xf6VZ\xd2\x95T\xb4\xb3\xeb Ck\xdb'L\xa4%'\x8a\x99\xba\xb7a\xfa\xd7\x8b\x88/\x12\x13Do\xedo\x13 \n.\xcd_\xc6\xc2\x87r\\[7z\x9a\xbb\x96\xe9\x97\xe5\xec\xab\x94\x87\xb2\xdc\xf5\xc7wO%\x15\xbdN\x83\xe8\xf3?\x9e\x1cW$\xab \xba\xbe|\xfb\xd48Z`\x1c\xba\xfe\xdc?3=\xbd\xdf\x7fm\x9d\xe8\x1f46R\xa1\x19rb\x1cH\xacc\xc2ib\xb1xY\x1a\xe0vKz'a\x02>\x90\x1b\xfa\x87w$E\x11[\xd5!\\_\xbe\xb5h\xe6\xd5\xf5go\xb7\x06n\xd6\x19\xe2M>2\x14$\xa5!,K\x03\xb1,y\x82\xe8o\"\xef\x05\x97$\xa1Io\xc7\xea\x8f\xf4\xdd\xb3\xc4dA\xf4O\xfc\xb1\xeb\xcb(\xbe\xbe\xe8z\x18C,>\xce\xa8~v\x10\x7f\xb5\x01E\x1c\xc7]\xaf\xc3\xc3\x89KY\xfb\x05\xf1\x07C\xcf\xab\x0d6\xe1\xc4\xf9n\xec\xf6\xf2\xa1W\xad\x0fM'\xd5t\xe2u\x88\xf90\xa1+Rr\x83)\x9b6\x1ag\xda\xd6\xce\xdc#\xfb\xde\x7f\xac\xb6B`+`\xa6yK\xa3\xa9\xe9\xed	\xfd\x81\xd0\xa4`Xg\xb5\x91M\xae\xe0\xfa\xe3;\xef\xabL\xc0\xe5\xf5\xfbg\xe7\xf0\x16	E&?\xc0\x16\x8f\x9c,\xfaC\xa1\\\xa6\xb7U\x10}\x90)F\xf0,g1\x90\x8a\xea=\xc5<\xdbx\xb9#\xf8mYo\x07E\x883\xc9b\xea\x17\xdc\xe1\x19U\xddu>\xb2\xb8vS\xe0\xe2\xbe\x06\xe3\xf7K\xc9=\x177\x0b\xa2zG\x89\xfbl\xa1\xb3v\x0b]\xdb\x92\xcb\xc6\\\x10R\x0c\x0b\xa9\x99=\x11\xc4R\xaa\x84	b\xe8\xc3!\xd5\xab\x84\xef\x98\xd6\x87k\x8c\xb9\xff\xa6J\xba\x83\x05V=\x1d\xe4w\x84\xe7I\xdcw\x84\xdf\x93Z\xdb\xdd\x805\xad\x8f\xd6\\\xa6C.S\xcf\x02=\xa6\xef\xc6\x9c\xf5\x7f\x0fU\xdd\"\x82\x15}r\xd5\xa5\x0f\xcc\xc8\xeb\x1c\xaa.B\x16B\xd5E\x94\x9a\x13~\xddE\xd2B\xa8w\xc6=sc\xcf&\x1b\x8fw\xcb\xfc^\x81\xf6\x11n\xad\x0bW\xb7\x8f\xa2^\xdf)\x8b\xcc&\x1b\x10\xb8\xfe\x19\xb2\xb5\x07\x9d\xfa\xbb\xca\xb6\xcf~\x9bM\xda\xfd\x86\xb5\xf3R\x96\"i\x0e\x96\xed\xde\xa2&\xb47+f\xb7B']%\xc4\x90\xde[\xa0?\x90\x98*\x96\x04\xd1;\xc5\x12\xb0k\xde/.\xcfZ\x1f\xb5t\xbe-\xee=\x99uf\xfd\xf1,NS\x0b\xb0|\xb0\xbf\x7f%\xd4\x03\x85\xfc\xac\xdd\x0b\x9e\xcc\xae\\\xfd\n\xf9\xa54F\xe6C\xdb\xb4\xf2pE\xefF\xb8\xb6\x96\xa7JqO\x8d\xd3\x95i'\xe2\xcdS\xf3\x8c,\xfc\x12\"<|\xf6\xe2\x85s\x90\xb6\x9b\xd2\x87\x8b\x90\x82\x06Q\xc6\x92\x84\x8ao\x85\xb1f\xfda,\xd7 \x87\xe7\xea\x9ao\"\xb8u\xe4\xef\x87\x93\xcc\xda`\xe5\xd9\xfd\x887\x02O\x07\x90\x0d\xda\xe5\xa5\xc5HA\x8a\x82\xb36T\xf8-\xcc\\\nu\xfdCN'\xde\xd0\x1e]\x0e\x1fz\x01\xcf\xb4}\xca\x896 \x05\xf5Y<H\x88\xceh\x021S1\xa7\x17p\x01\x89\xcb\xd3B\x0es\xa2\xee\xa8\n\x1aP\xfb\x03\xaa\xfd\x1ca3\x96\x84\xe0\xe6\x85~\xb8\xbb\x1f&\xd2\x848\x13\xd7y\x01n\xbe>\xf7\xc4CPL\xa4\xd8\xe2R\x12\x85G5Y\x85`\xb0!.\xe5\xb4\xc9\xb7E\xadH\x8e\xf4-\\\x13B\xc1K\x1dn\x16\xd3;B\xf6\x87\xe34\x97\x05\xbd\xb5\xadTA\xf4\x19o|_\xd5\x9eA\xa5MB\xdb\x04\x9f\x17Y\xecn{0\xa2`\x89\xe6+\xa9\xa76ycx\x1dD\xb6\xf4\xdb\x06_\xf4S\x93	\xc7%D\xcd9\xfd/\xe7>\x0ec?\xed\xf4\x1bp\xf0\x92)j\xbb\xc9\xbc\xf6\xe5\n\x1bf\x16\xe0\x9b\"\xe5\x9a:\x80O\xd1\xd4\x9f\xb16n\xdf\x8a\xea\x1c_\xd3\x147\xb6v\xed\x97v\xedh\x12\xdd\xd0\xf2\x08\x96\x90\xc9\xa6*\xc4\xfc\xd6\xdb\xb9\xf6h\x07\"9\xe3\xf5-\xf6\x0e\xbc\xb5\x97\xb8\xf0v\xb9\xe7\xbb\x8dz\x9d\nz\xd3\xd8\xe7\xab\xd0\xc97\x96\x9c[P\x82_W5\xf9n\xc5\xd2\x83\xe5\xc3\x16\x9c\xb0\xd1\xc4\xf7\xe2\xb8Wy\xb6A\x14n\xb6{\x11\x99Fk\xac7\xef\x13\x1cj\xeb\xe2(\xac\x94\xcc\x81\x928\x83\x820\xe5#\x94?FX\xf9\xbf\xcaB\xe1\x0b\x1fW\x87\xd3\x10{6'\xa3\xd3\x10<4\xad\xf0\x18bi\x9e\x1by.\xd0\x13\xfc~k\x8eT\xc3\xe9\xf9\xc9\xf9Y\x13\nq\x8emo\xf4;\x02\xd7J\x0dV\x8d]7\x91\xaa\xdb\xa39\x82\x9b\xed\xedj\xf3H,\xb9T\x1b)SE\x12\x868\xb9ge\xb9\xe3\x9b\x8fL\xde\xfb-d\x9b\xf0\xd7\xcc\xd4M\xeei\\u\xafB\xb5?\x80^dD\xd3 \xba\xc6\x1f@\xe4O\x11\xd6\x82i\x9b\xd6al]l\xfa\xff\xf6-f[\\\xdd\xf1\xda\xf5\xc3\xfdN\xf1{y\xdf\x16\xb0\xae\xc8\x9f\x16\x16em\x83\xb2\xefg\x06\x92\x12&\xb4\xf1O}\xd3\xb3\xb7\x98\xb7S\xc7;\xdb\x90\xb8\xf68k\xd3\x0d\x8c\x13@\x94\x9c\xc7v%]\x82/`\x01\x13\x0c-]\xee\xf6q\xc7\xf3\xbe\x94\x8c\xb3\xa5b\xc4:\x90\xcd\xe5M\xd7\x01\xbel\xa9\xa70\x84zz\\\xcf.\xdc\x0f\xde\xce\xac3>\xd6\xc2\xdc\x14\x127\x9bf\xf0\xbc\xd4\x06\x91ZR\x1a)d.1\xd9\xb3\x11\x1d\xb9\x7f`\xb6KhA\x85\x85\x0d\xaa\xde\xf1\xb9\xff\xdb\x01\xeb	\xb7\xdb\xad]\xee\x05U\xe3x\xae\xd1\xd9l\x19\xed\xd9\x07\xfe\xf6\xedA\xcb\xf3Gt\x9e<\xed\x8b\xbf\xee\x02Ch\xde\xafki\x93Q\xa6:\x10\x91\x8fo\xde\x88\xd6\x11\x0em|\x9b\x8cN\x8f.\xe0p\x16\xc2\xf4\xc8e\xe0Va\xb5\x8dw\xd0\xc9__\x83BO\x1a\xd6+jY\x1ac\x0b\x1a_\x92\xb9\x17\xd6\xeeH\xef\xce&\x8d\x8e\xf1\x9d\xc3-K\xda3\xbd\xfb\xd0d\x1c\x1dtf\xa2\xbfh\xb2\xa6o\xb7{\x16\x1c\x93[\x07\x118\xda\xba\\\xe6\xcc4\xb4\x9b;O\xfa\xb3\xbf\x1d\xef4S\xce\xc7\xa5\xad\x1d\xe7c\\\x8c\xbd\xda|6#\x8d\xfd\\f0\x18\xbc\xa3\x82\xb6-\xf7s\xe2\xbf\xfb\xd9\xfaV\xa9\xc8\n$0\x92*\x0d\xa2\x02\x97:\x1f\x13\x1b\xe4\x89\xb8\x83Z\x96!\xcc&\xb3	\xfa\xb3\xfb\xbe\nGC\xea\xc8J\xa5C\x1c\x03\x84syO\x13\xc8m\xff{\"\xed\xdeZ\x96\x8c\xbbh\xf1\xfb{8?:\xd8xR\xfb\xdb|\x0e\xe4\x8e\xa7\xfe\x8b w\xd3\xfd(h>\xc6\x8f\x88\xa2\x83\xf9839\x8f\xfe3\x00PK\x07\x08\\1\xd8\xf6\x0d\x0e\x00\x00\xf45\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00#\x8egQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00	\x00	\x00plot.htmlUT\x05\x00\x01\x13\xde\xa6_\xac\x94\xdfo\x9bH\x10\xc7\xdf\xfdW\xcc\xed)RN2^|\xb1\xa2\x1c,HW\xc7\xa9*\xb5i\xa4\xbaR\xfc\xb8\x86\x01\xb6]\x16\xba\x8c	\xd4\xf2\xff^\x81qD*K\xad\xda\xee\xcb\xee\xce\x8f\xcf\x0c\xcc\x17\xc4_\xb7\xef\x97\xeb\xcd\xc3\n2\xcau8\x11\xa7\x0de\x1cN\x00\x00D\x8e$\xc1\xc8\x1c\x03V+|*\x0bK\x0c\xa2\xc2\x10\x1a\n\xd8\x93\x8a)\x0bb\xacU\x84N\x7fa|\x9c\x98\x11\x95\x0e~\xd9\xa9:`\xcbc\x92\xb3nK\x1c!\x08\x1b\xe2]]\x1f\xa2L\xda\n)\xf8\xb8\xbesn\x9eA\xa4Hc\xf8\xa1\xd0;R\x85\x11\xfcx\x9f\x08~\xecRl\x8b\xb8\x0d'\"V5T\xd4j<\"\x1d\xa9Uj<\x88\xd0\x10Z\x1f\x92\xc2\x90\x93\xc8\\\xe9\xd6\x83\xff\xad\x92z\n\x954\x95S\xa1U\xc9\xe0\xaf\xd4W\xf4`~S6>\x1b\xaag\xf3\x13\xb5,*\xd5u\xe0\x81E-I\xd5\xe8CT\xe8\xc2z\xf0\xf7\"\xd9n\xe3k\x1friSe\x1c*J\x0f\xdc\xd9\xbf\x98\xfb,\xbc]-\x8b\xfc\xc1\xca\x88\x04\xcf\xe6'\xec\xd5\xcf`\xaf\xfbu\x1e\xbbA]e\x92\xe0vW}\x96Z\xb5XO\xe1\xd5\xfc?\xc7]\x08\x9e]\x0de\xca0\xb9l\xa6\xed?\x10\xc0~?\xbbk\xda\xc3\xc1\x87\xf6\xb2\x99F\x83i\xd3D\x9diy\xd9\x88j\xb7\x0d]\xc1\xbbm\xda\x8eoC\xe8\xb2q[\xf7p\x10\xbc|\x86\xbfH\xea\xa3J\xab\x0c%\xc0.f\x8b\x84\xc1\xec\xd1\xed\xe8\xed\x8f\xc26}\xd8\xe3Y\xc2\xca\xc4\x9d\xf3\xbew\xce\xee\xfbs\xcf\xcb\x95\x19\x11g\xf7\xef\x94\x199e\xf3\xd2)\x9bq\xeb\x122\x8bI\xc08\x0bW\x9d>@\x9a\x822\xb4\x10K\x92\x82\xcbN^\xb1\xaa\xc3\x89 \xb9\xd5\x08\xbd\xb4\x036w\xdd\x0bv\x1a\\/0g\xd0\xf1\xaf\x0b\x0d\xce	\xe0\xdc\xc0\x8f\x9d\x93=\x1e\xba%(\x0e\x85\xca\xd3\xef\xda\xb3Q\xc0\xba\xe7\xf0T.S\xe4\x9f\xca\xd4\xdf\xca\n\xaf\x17\xd3\xfd~v\xfa\x8e\xaa7yz80\x90\x9a\x02V\x9d\x8cP\xea\x82X(8\xc5\xbfW\xe6\xedz5.\xa0	\xff\x18\xfa\xf5Ktz\x06-x\xf7\x9a\x04\xef\x87\xd7\xcdr\xf8G\xf0\x8cr\x1d~\x1b\x00PK\x07\x084\xe7\xb3\xaa4\x02\x00\x00\xf6\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00#\x8egQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00shadow.gifUT\x05\x00\x01\x13\xde\xa6_\x00.\x00\xd1\xffGIF89a\x07\x00\x02\x00\x80\x01\x00\xcc\xcc\xcc\xff\xff\xff!\xf9\x04\x01\x00\x00\x01\x00,\x00\x00\x00\x00\x07\x00\x02\x00\x00\x02\x05\x84\x0f\xa1\x1b\x05\x00;\x03\x00PK\x07\x08\xc3\x86\xc1\xa35\x00\x00\x00.\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00#\x8egQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x07\x00	\x00top.pngUT\x05\x00\x01\x13\xde\xa6_\x00\xa1\x01^\xfe\x89PNG\x0d\n\x1a\n\x00\x00\x00\x0dIHDR\x00\x00\x03\x02\x00\x00\x00\n\x08\x06\x00\x00\x00\x07\x07\xe9H\x00\x00\x00\x04gAMA\x00\x00\xd6\xd8\xd4OX2\x00\x00\x00\x19tEXtSoftware\x00Adobe ImageReadyq\xc9e<\x00\x00\x013IDATx\xda\xec\xdb\xebn\xc20\x0c\x06\xd0\xa4\x14\xf6\xfe\xaf;\xc6\xb2\x15\xb5\x93\xeb^\xb4!\x95M\xda9\x92I \xe5\xbf?\x1cjk\xad\x00\x00\x00\xffK?\xbc\xd4Z\xf7\x9e\xa9\x0f\x9e\x01\x00\x00\xc7j\x8f\x9c\x0d\xc3\x80\xfe\x1b\xcd}]\xd9W\x81\x00\x00\x00\xfeL\x00h?\x0d\x06\xfdFC\xbf\xb6\xc6\xda\x0b\x05\x00\x00\xc0\xf3\xc2@\\c\xd5t\x16\xdf\xcf\x82\xc0^\xf3\xdf\xa5u+\x14\x00\x00\x00\xcf\x0d\x01\xb1\xde\xd3\x9aC\xc0W\x18\xd8\x9b\x08\xc4\xe6\x7f\xa8S\xd8\xe7P \x0c\x00\x00\xc0\xef\x84\x80\xd8\xfc\x0fu\x1b{\xf3\x1c\n6'\x02%\x85\x80.\x85\x80>\x85\x81\xae,'\x04\x00\x00\xc0\xf1A\xa0\xa5\xe6?\x87\x80\x18\x06J\x99O\x08V\x83@\xbe\x0et\n!\xe0\x9c\x02\xc1\xa9,\xa7\x03\x00\x00\xc0\xf1A \xfe\xda\x7f\x1b\xeb-\x04\x80)\x0cL\xcf\xd7\xb0\x9f\x05\x81|\xc5'N\x02\xce+\x95'\x04\xd3w\x00\x00\x80c\xe5\x100\x05\x80\xebX55\xfe\xf9\x0f\xc4\xf7\xcf\xd7&\x02\xf9:\xd0\xd0\xf8_>\xebe\\/e>!\x88a\xc0T\x00\x00\x00\x8e\xd3\xcar\x12p\x1d\xc3\xc0\xeb\xd8\x9bo\x85\x80\xa9\xee>\x04\x18\x00gz`\x82$L\n\x02\x00\x00\x00\x00IEND\xaeB`\x82\x03\x00PK\x07\x08Q\xf1\xa7\xdc\xa8\x01\x00\x00\xa1\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00#\x8egQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x08\x00	\x00view.cssUT\x05\x00\x01\x13\xde\xa6_\xdcZ[o\xe3\xb8\x15~\x8e~\xc5\xe9\x04\x01\xba\xd3\xb1\"9q\x12+O\xbb3\x93\xed\x02S\xa0\xc0\x16})\x8a\x80\x16)\x8b\x18IT%:q\xd6\xc8\x7f/\x0eo\"%\xd9\x93v\xbb(\xdad'\x1bK\xe4\xb9|\xe7\xca\xc3l\x04}\x89\x0e\xd1\xd9\x86\xe4_\xb7\x9d\xd854;/\xf0\xeb>:+D#\x17\x05\xa9y\xf5\x92\xbd\xfb\xb2\xcb9%\xf0cG\x1a\xca\xde}\x80\xbf\x90R\xd4\xe4\x03|\xdfqR}\x80\xbf\xb2\x8e\x92\x86|\x80\x9e4\xfd\xa2g\x1dw\x14z\xfe\x0b\xcb\xfa\x9aT\xd5}tV\x93n\xcb\x9b\xec\xae\xddC\x02\xe9M\xbb\xbf\x8f\xce$\xdb\xcb\x05\xa9\xf8\xb6\xc9r\xd6H\xd6\xddG\xafQt^\x88\xae~\xccE#	oX7#\xe4}t\xb6\x11\x1de]\x96\xb6{\xe8E\xc5)\x9c\xe7y>\xb0I\x80\xec\xa4\x08YT\xac\x90\xf7\xd1\xd93\xa7\xb2\xccn\xae\x13\x14\x01\xd9I\xd1\"\x0f\xca\xfb\xb6\"/\xd9\xa6\x12\xf9\xd7\xfb\xe8\xacd|[\xca,U\xeb,]\xfc\xa4HC2\x90Z9R\x85\x10RK\x1cp9\xcb+F\xbal#dy\x1f\x9d\xe5\xa2\x12]v\xbeV_\xf3(\x8c\xb6\xb7\x84R\xdel\x17\x1b!\xa5\xa83HW\x8a\xea\x002\xdc\xad.,v(\x01\x90\xc3\x11>\x94\xe5\xa2#\x92\x8b&\x83F4\xccA9\x10o\xf7@\x85\x94\x8c\x82\xdb\x8b0\xe9\xf7oF\xcaY`\x0c\x12Z7&m\xdbp\xd9\x89\xe8\xe0\xa0]\"\xb4\xeaG2\xa8\x9c%\x90\xa8\x87J\xbb\xcb\xf7\xef\xdf\xbf\x87/b+\xe0g\x96\xa3\x12\x00\xf8\xe8\xfdeT\xa6\xa1\x9f,\x8c\xf6\x94\xe1\xb7\xe7\x18\xf8+o\x16F\xe6\x80\xd5\x0cF\x06\"\xf5\x987\x9452[\xdc%\x896\x0b\x8aT\xa6@\x90\xf3	T\x92\x8b\x90\xa71\xaaxb]Q\x89\xe7\xac\xe4\x94\xb2F)\x18\xf1z\x8b\xd46\xac$O\\t\xd9\xae\xab~\x9f\xf7\xfd%gm\xb3-\xf8>.e\xfe\xdd\xe0\xfeZ\xbc\xd7\xc8B\xf3 \xba\xdaA\x83O.\xa3\x00j?\xb0\x83\xb8\xfew\xc3\xfa5\x1a\x18@\xc5=\xcfO/\x9c\xb5aWE\x07\xdf]\x0d&\x15\xef\xe5\xa2\x97/\x15[\xc8\x97\x96Y\xb0\x8d?\x8cM\xa3\xfdHo\xb5\x845\xc71\xf23\x04\xae\xdb=\xac\xd0\xbf\xda=\xac\x95\xf1Z\xd1s\xf4\xa0\xacc\x15\x91\xfc\x89\x0d\xe2V<#\x85\x89\xe3Q\xe46\x12]\xe0]\xfc\xee\xfe\xa8\xc1Q\xd6'\xde\xf3\x0d\xaf\xb8|\xf1\xad\x1bovR\x8a\xa6\xffm\xa9O\xe8\xce\xc3\xb3\x90\xa25!\xfb\x1aE\xef\xa1\x94u\x05F\xfd\xe8\xe0\xf8\xa5\x17\xfe{\x9f\xc7\xec\x02C\x00(\x7f\xf2-\xc3\x9b\x8a7la$\x18p\xb6\xebL\xb0^__{\xf6\x074Z2\xc9\x06w&\xd9ZV}K\x9a	\x8d\xa2\x12D\xda\x8c\xff/\x12\xa4\xfc)\xc6\x9dS\x05\xc6\x84\xb5K^\xdf]L\xf6w\x18\xe9'\x08\xa8\xf7') \x0b\x88kF\xf9\xae\x8e\x0e\xc7\xdc_-U\xc4N\xac\x8d\x95\x13\x8f\xfd\"$\x02\x15\xd9\xb0j\xbc&4\xcc\xd8\x91\x86\x80\xd6!\xa5ll\xdcb\x1d\xd4\x82\xc1\x84\xca\xed\xaefL\xf8_\x15 \xa6D\xb2\x96\xe7_M\xd0\xef\xba^tY+86%\xf0;^\xb7\xa2\x93\xa4\x91c\x07\xb01p\xe3k\x1b\xa7\xac\x86\xd5\xd4w\x9d\xb9\xf5r4\x0c\xf2\x7f\xa4\xac\xcf;\xdeb2B\xf5\xc3r\xecWc\xdd\xe1\x04\x19i>\xc0\x06\x8fO e\xf5<\xaf\xbf\xe5\x15\xe9\xfb\xbfG\x87	\xaes\xab\xa1\\\x0e\xcea\xe2\xcaK\xe87*\xa1\xab\x07\xcf\xb6\xca%\xa1 \xd6\xe8\x13A\xa0\x0d\xab\xc3zu1r\xa6\xf4*\xb9\x18i\xb54\xd4\x90\x18\x94\x9d\xaf\x85+\x8a&F\xe2^\xf7\n\x8f\x9b\x8e\x91\xaf\x1e\xc4\xe8	S|\xbd\xf4h*E\xd8}\xf9\xce\x8c@\x8cV)\xa2JWc\xed\xf5\xedE\xe0A6\xeev\x15T<.x\xd7\xcb\x91L\xa8@\xe8t\x9eLI\xf8\xc6\n7}e\xf9\x84\xeaCy5\xaa\xc5\xe9\x11\xd3\x9d6@\x80\xff\x88\xc5\xc8\x9e\xaa7\x0d\xad\x176t?\xe8\xb2h\x1a\x16\xde\xb4;i\n\xcd#6^\xd1\xc1k\x96Te\xad\xd8\xa0y\x96\xc0\xad\x876\xf6\xe6A\xb1\x05E/\x94(]z\xea,T\xfa\xccV\x81H?\xe1\xa6\x1eHC\xe1\x0b&F+\x9cJR\xf1l\xc4\xda\xf6\xc5d\xac\xe5ry:e\xae\xc6\xb0\xdfNa_%\x17\x83\x8d\xb5\xe3\x1b11e\xc6\xfdK\xbd\x11\xe3\xde*=\x12>\xaf\x91\x81\xd6b::\xf9\x01\xf6\x9aq|\xa9\xff\xe35\xd9\xb2\xfe\xb2/	\x15\xcf\xf1\x96\x17\xdfA\xc7ZF\xe4b\x0fR\xb4\x93c\x83w\x10\xa3\x94\x0e\xafU\x84\xf8\xa7\xb4+\xfc\x1e\xdek\xf0O,\xb0AjNy\xb79~\x0f0_]]Y\x18\xfd\xd6\xd2:\x9b\xe7'\xd8\xfa%\x1e\x0c\x05\xafXt\xf8U\x84\x10I\xd21\x12\xdb_\xfe\x1fa\xfd\x0f\xcd\x01\xa6\x861\xe9q\xad\x9b\x94\x9eU,\x97\xb1\xfe\xdf\x9b\xed\x92\x8e\x8e\x8b\xa9k\x19\xff\xa7\xbd\x1bOt:X\xf3]\xd7\xb1&W\xa3\x1ao\\b\xfaG\xe7\xccy\xc9\xf2\xaf\x1b\xb1\x9f\xa9\xe6\xaeI\xb9\x9a4ii|\xcd\xea!Zn4x\xe0W\xaf\xd4\x96m\xcd\xa7#\x94\x8b\xdf\x8e\x89N\xafy)x\xee\xc7\xe6\xb7\xdaO\xe3['T[\xa4\xf1j\xc5j\xec\xfea\xb9\xf2\x0bv\xa6\x0f\x05+O\xe7u2\xe3\x92^\xbb4\x93\x13\x8c\x1f\xe2I*S%}\x91\x97\xbc\xa2\x7fP\xa7\xaao\xd0\x19\xf6\xc6=)H\xc7\xc3\x0d\xa3\xf4\xbeL\xc2v\xc2P\xb1\xe3\x1b[\x1f\xb4\xb9\xd4\xf0m8\x11,\xcd\x94\xc8\xd2\x9f\x7f\xab\xb7\x8eO\x13\xab\x10\x94c\xaf\xf5\xe6\x8at[\x16\x1d\x8e\xc4\xf8\xe8\xad;\xd3\xb8t\xea\x043\xc6\\\xc5+V\x87K\x06\xfe\xd6\xe0\xc9x\x89ccV,\xed\n=F\xfa\xdcu\xa2\xb3u\xfd\x9c\xe1\xa7\xc7\x9a\xf5=\xd1\x9bF\xf9\xc3\xa5^\xbfc\xec\x18\x9d\x1a\x80\xd5C\xd1\xd1\xe5\xcf\xef\x18;7#0\xae\xa3R\xc0\xf5\xb1q\xe8\x08\xc0P\xccG\xc9eP\xc3>=$I\x92\x84\xe9R\xd9\xdc\xc8\x98\xddz\xben]7\x99\xa3\x8c\x1d\x8eGxB5L\xe6\x90@|\xc7\xeac\x84\xa0\x97\x9dh\xb6\xf3\x03\xba\x87\x87O\x0f\x9f\x1e\\\xe5\xd1\x90Z\xd9\xb0\xd4\xda\x04d\xbbyE\xff\x14\xad0<L\xc1\x1c\xc2\xc3\xe6\xda\xcf\xdf\x7f\xfc\xe1\xe3\xc7\x13%\xd3-0\xe0]\xb98\x0dD\xf1\x0e\xae\xbe\x15\x02)\xac\xf8\xed \xfdh\xb04\xe8\xee\x97\xbav?:U\xdd\x8eOU\xb6mU\xe4\xe3\x8e\xfdc\xc7;F\x07\xd3i<\xf5\xa1\xd54\xa8~\xab\xaf\xe8\xb9\x90\xf8q\xc7)\xc3,\xaa;_\x15!\xf0G\xbe-+\xf4Z\x13*V\xf9\xd2>g4\x8c\x17k\x8d\xa2(ns\x0f\xb0x\xeb\xc8\x87\xe6\xcb\xce\x8b\x15~;[\xf8\x9d	\xbb\xc1\xef\xfb\xf1|\xc7\x81t\xa7<Q\xc5\xd9\\\x19\x98\x9c\x1c\xcd\xbc\xc7\xb9\x18^H\xa4\xc9t,H6\xbd\xa8v\x12\x01\xc3\x08=2\xd53\xf1y\xbdD\xc6\xbf\xa8\x11\xf1\x1e\xe5\x98W\x1b\\b\xf3\x8dl\x92\xf2\x0c\xaec\xc8\xbc\xb1\xa2;\x07Y\xdbW<+q\xa4\xfc\xc6=q#\x1e\x87\x85\xe3Mv 09\x89Nw\x1a\xb1\xbdd\x7f{1\xc7a8\x87G\x07\x87\xbew\x806N\x08?\xef\xf2\x9c\xf5=\xfcI\xa7b\xe3tzh\xd0\x9bw\xc3$\x02\xcc\x9d\x8a\x89\x88\xa0\x17\x845\x9a5i%\xa4I2N\xb0\xa0/\x9c\x86Y\x87\xa5].\xe1\x10\x01\x00\xf8\xa3\x0e\xfc\xec\x99LM;\xdc3\x13J\x8d\xe8jR\xe9\xe76@[\xa9\xf8\xdb\x14\xa6\x14\xfc3\xe9\xfbg\xd1Q\xa3\xd9\xae\x8a[\xf3\xe4\xe0\xedUe\xe1F\x89\xed=4Y\x0c\x86\x17S\x95\x90\x93#	\xe5\xd2h\xe3\xe7\xa6\xa9\xf0\x1bQ\xd1\x89\xe8x\x96\x1e\xce\xea\x03\xcd\xe1\x18\xa9\xa1\xf2\x90\xb9\x1du'\x00\xa0#\xe4\xea\xce\x8a\xfc-\x89U:UtGc)\x8b\xb7\xa9kSN\xd6\xa9\x86\xc9\xf6\xc8DZK\xac\xa3\x15\x7f\xccI+\xf3\x92\x1c\xfc\x9dh\xed\xcc\xe5T\x7f\x99\x1a3\x1f\xec\xf8\xcf\x8d\x98.\xb1\x91\xa87\x8cRF\xf5\xed\x0b\xda4\x8a\x19>\x83\xd1M\xa6\x9b\xf2\xd8\xab\xb7\xd7a\xa5\x14\xed\x07\xb0\x1f\xb4\x99\xdd\xe72\xf5\x1a\xee\xe9\xd6	\x13\x8d8\xb8\xbe\xca\x92\xd5w\x83\xe1A\x02\xcc,\xcfb\x87\x1f3ctKh\xbd\x9e\xa3\x13\x9b\x88\x19\xd1\xf3.r\x0d\xdfc\x17~::UMUWi&\x01|$\x15k(\xe9T|L\xbf.#\x1cy\xe7v\xd1\x01\xdcE\x0e\xb8\x9b\x1c@a\xdd\x12I6\x15\x83C\x14Nu\xef\xa3#W\xc8\x837C\xaa\xbai\x1d:\xa0\x9b \xaf-\x04\xacp\xf7\xd1\xaf>$\x07\xc2\x9a\xb1\x15\x1c \x9a	\x14\x8c\xf8\xc8\x98*S38p_#:\x0dy\x82C4Wd\x83u\xb2d\x84B\xac:Id\xea7\x07\xa0\xe2\xc5P\x9f\x0b\xdb\x00\x0c{\xd3\x1a\xc0\xe5\xcb\xaa\x0f}\xa6\x85\xf2\xcc\xa3%\xc0\x9f\x9dxF\x19\x02\xb2\xb65\xb0dU\xfd\x9f\x0d\xea	IJ^\x1aR\xb3~J\xb3(,A=@z\x1b=$\xa6h\x1d\xbf0W\x1e\x14\x18h\x16\xb8\x00\xa39{<3\xf6\x955\x14\xb9\xd9\xb5777G\x90\xc3\xea\xceB%M\x0ff\xe0\xb2\x1f\x94\xdeN:{T\x9c\x10$9\xde\x87\x1e!H\xd3e\x91\xae\x1d|!\xc9\xa5\x0di}\xd5\xaa\xc8\xfb\xf4\xf1\x8fN\x94]\x90\xb8\xb9\xd1\xd2'\x07+\xa2\x1a\x9b\xfa\x90\x99IG\xc0\xc2\xfb\x87,\xe6\x18\xc4B\x96\xac\xabE#K8\xf81\xad\xfaE\xcbm\xb3\xd9\xbc\x81@,\x9c9\xdc\xceB\xef\xf4y\xab4\x13?7p8!\xad\xf1\x1d\xa5U\x06^\xee\x99f\x97\x19\x83k\xe9:\xf1ll.i\x18\xe3p\xfe\xf0\xf0\x90~\xff\xf0\xed}Z\xce\xb7n\x95\xd4\xf32\xa7\x1cv\xe9\xde\xbf@|t\xbc\xa0>\x0f\xfe\x12<\x9e\x91SR\xcf\x03}7\x0b\xa4]-\xd7\xcb4d1A\xddw\xc31\x1a\x92\x9aY#\xa3\xf3y\xcf\xd6\x07\xdfHwww\x9e+\x9e\xd2\xdf&\xae@@\xeb<\xcb\xe5\xf2\x0d \xbc-\x07Xe\xa40a\xe5\xe72\x9d\xc1\x1d_\x8dYh\xa7O\xeb\xcf\x0f\x1f\x97\xb32\xc4\x94\xf7\xe8\xd4(\x82%\x81\x7fJ4*\xafJ\x82\x98\xd5\xad|\xc9YU\xa1\x0c\xdeA\x05\xfc?\x80\x98\xddd\xb2\xfeL\x833,\xc7\xd6\x05b\xfciV\xcfe\xd5\x00\xfe\xd5\xeafP\\e\xa9P\x00\xa4\x05\xb1\x94\xbc\x0d\x13\x9d\xcdA\xc1\xd6!\x7f\xa4\x89\x17\xc5\xd8h\xfa\xeeaK\xa0\xf3\x10\xdb\xf8O4\x19\xa2)\x10\x9a\x90\xc2\x12\x0f\x08'\xd7\xc5\xa8\x0f\xf1\x9dpl<\xad\xda\x10C\x01\x8b\xdb\xdb\xfc\x1b	{\xa0\x14\xe7\xa2\xde\x08\xccg\xae\xc7\x1a\x8e\xc5#{),\x148\xba\x85T\xbf\x9a\x06R\x0d\x80g\xd4R\xd5\xf2d\xb5W\xa6\xf0\xb2\xb7\x1a\xcd\xdas6v\xb8\xf3\x12\xc7\xea\x10\xf1a\xaa\x8a~\xb1\xf8\xe9s\xc1\xf7p\x98\xf5\xa2\x13\xc8\xce\x13\xf1\x95\x9c]n\x8d\x1d\xaa\xeaU\xe6\x19\xff\xb4\xac\xac\x15\x8f\xb9\x1c\xc6\xa3}gO\x87\x1e\xc2\xfa\xb5\xcf\xd6\xfa\xe8L\x9ax\x8d\xa2\x7f\x0e\x00PK\x07\x086\xe9[\xc4\xfe\n\x00\x00\x16*\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00#\x8egQ\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x07\x00	\x00view.jsUT\x05\x00\x01\x13\xde\xa6_\x84U}s\xda8\x13\xff*<\x9a\xe7\xeaUQ\x94\x08\x12^\xea\xea:I\x9ak\xd3K\xd3\xeb\xdb\xb5=\xa0\x1ea\xcbX\xc1\xd8\xae-B\xa8\xd7\xdf\xfd\xc6\x06Br37\xf7\x9f\xa4\xdf\xee\xefe\x97\xc1\xfaV\xc5\x10.\x13\xdf\x9a4\x81\x8c)\xe6\xb39\xd3,\xa7\xa5\x96\xf7\xef>-sm\x97y\x02\xfes\xf5\xc2q\x9ei\xc8T^\xe8\xcb\xc4\x82\x7f\xa8(\xa5m\x00_\xfa\xbf(\xfak\xf7\xe4\xc5G\x9b\x9bd\xc6\xc3<]\x9cG*?O\x03\x0d~\xbb3\xa4\xcf|n\xd3\x0d\n\xdd\x1e\xa5\x95kB\xf8\x9f\xe3\xf0\\g\xb1\xf25\x1c~?d\x9bnJ\xcbUdb\x0d\xfe\xc1\x01\xcdG\x1a|:\x91\xf3\x91?A\xac\xcf\xee\\\x8e\xee\xed\xe9\x9d\xbdV>\xd2\x93j\xe2>\xb0\xbe\x83\x9c\xf1x\xd5v*\xd7\x97\xa2r\xf7\xcc&\x84\x9a\x94f2\xbb7\x91\xe8U\xeb\x83\x9e]\xdce\xe0\x8c\xc7S\xa7]\x0b\xb6\x9b#sf\x0eeM\x87\xbb\x95\xcc*p\xba\xd0\xe7_i\xd9\x1f\x91\x0f\xa4\xad&R\xb9\xfd\x11\xf9\xd9\x1c{\xb0\x7f\x87>\x17sZ\xb9}\xfe\x15\x88\xd0\x84\xed\xaahuQ\xf6\xb9\x18\x02\xf9I\x98b\xe2\x84V\x9d\xd6\x8d|\xdd\xfa\x13\xa8\xdbk)\xa0e\xa7\xa5\xe5\x0f.\x02 B\x11\xeav\xeb\xd0)hF\xfe \xd4\xed\xb4\xae\xe5\x19\xfc`\xe4)aD\x1c78h.:\xcf\xa58\xa2\x88p-%!\x94\x96\xfe\xa6\x83\x05\xb4\xaa\x8e\x1f\xb4$\x84\xbas0\xf2\xc85\xcf\x8f\xf9\xd45\xed6-\xbbp<2\x13\xfe\x9b\x94D\xcc\x08\xe2\xfe\x16>\xba\xf9\x84\x96\xcdML\x9b\xb89P\xd7\x87[~\xc2O\x18	\x1b1\xb7\xc1\xdf\xfd+|\xc3_\xc2\x0d\x9f\xb2#V\x17\xd6\xe3\xf8\x8f\x86\x06\x16\x83\x06O\xf7Z\xb4\xaa\xaaN\xeb\\\x8a>\x17=.\xbaP\xcf\xe6T\xfe\xe0o\x81\x08\xd1\xcc\xe5\x9c\xff\x0e\xe4\x0d\xa1mQ\x8f\xe3tt4a\xe4Mcr\x8b]>\xc6.\x1b\xac\xea\xb5r\xa0\xe5\x1c:\xad\xcd\x94nvSJ\xe1\xa6\xf6\xb2\x0d\xdbT\x9e\xc1\x82\xad\xd9\xaa^\xda\x9d\x84\xb5\x94\xe4)y\xf2d\xc1\xbf\xd1\x17\x0b\xfe\xed\xd9\x82\xbf\x85um\xec\xd5v\xc3+\xb9\xe2b\x01\x87\xe3\xf1\xc1\xe1\x8c\x91\xf1x<>h\xb6z%_\xb7D\x0c\x04\xbec\xfdXP\xd2^\xb5	4g\xfc?mj\x12wo\xeang*\x91w#3q\xbbp\xc5\xc5\x0d$|@i\xf9\x8a\x0b\x03	\xad*\x11\xc1+Z\xf5Z)d\xecS\xbd\xe6\x8c\x0fj\xaf\x91\xcc\xf8\x80\xff\x05\xa4\xd5\x10\x7f\x96\x9f\xb8\x05\xfa\x80>\xda\xd1w!\xaa3[\xa0R~\xa6e\xc4_\x82a\x82\xba\xe6\xe0\xa0\xaa2>\x90\x11\xff\xd8\xd0\xd4\xd3\xf0!fK\xf6\xbe\xee\x8a7BC\x19\xef\x85\xba\xf0\xbe\x16\xff\"\x97\xffP\x1b\xee\xd5\x86\xf7j_h9|\xa4V\x0dGC>\x9d\xc8\xa5\x1b\xf3\x81\x1c\xeet/\xca\xfa\xba\xac*\x87\xf5:l\xd0c\x0e\xe2\xad\xca\xd1\x84\xa8c\xbd\xd0\x89-0S\xb9N\xecu\x1ah\xdc\xfd]\xe0\xca$A\xbaB?VEq\xad\x16\x1a=\xd1C\x93\x18kTl~j\x8cu2\xb3\x11\xaa 8\xbf/\xb1\xf9\xb2\xae\xc3\xc8\xcc\xa2\xd8\xcc\"\xab\x03DO\x1c!\xea\xd8Sy\xae\xd6\x18\xa69z\xa2\x8b^\x0f=\x1fs\xbdHo\xf5\x9e\xc0\xd3\x18\xa4\xfe\xb2v\x85\x85\nUn\xbc\\\x17\xda\"\xda\xf4s\x96\xe9\xfc\\\x15\xb5\xc21\xda\xc8\x14\xe8\x0d\xd0\x1b\xa2\xd7\xc78U\x01z\xc78\xd3\xf6b\x1b\xeal\xfd\x80\xb6\x8bE\x16\x1b_\xa3\x8e\x0b\x8dv\x9di\xf4\x14&z\x85\xa1\xc9u\x98\xdem\xd5\xd0$\x81\xbe{\x17\xa27}\xcc\xf5I\xcd6C\xe8`\x9a\x84\xa9\xbf,0I\xbd\xd9\xd2\x04:6\x89.\xd0\x13'\xa8oub\xbd\xc6\xcbMj\x12\xf4B\xf4\x84\xc0\xd3&\xb8'\xfa\xa8\xacU~tQ\x97\xa1\x8a\xe3\xc6\x94\xc5\xe3\x93#\x9c\xa6\xc1\x1a\xd30,\xb4\xfdb\x02\x1b\xa1M\xaf\xd2\xd56\xef\x03\x99P\xd5\x01\x96\x85\xceOg5K\xa2n\xcdL\xd94\xc74\x99\xc6\xcb\xbc\xdeG\xc3\x7fe\n\xab\x13\x9d\xe3B\x99\xc4\xdb\xd0'~l\xfc9\x86&\xd6\x0f\xd2\x9d\xad/\x03L\x93\xc6v\xae\x02\x93\xa2\x1fi\x7f>M\xefp\xf3\xc7\x8e\xd9\xb2\x88\xd0\xea\xc2n\x12\xe2\xe6\x93\x80\xdb\xaf\xc4\xeeg\xe4\xf0&\x0e8\xe8Pv\xc4\xca\x8a\xd2\xbf\x07\x00PK\x07\x08\x92\xfbm\xc8Y\x04\x00\x00T\x07\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00#\x8egQ\xde7\xe4\xf08\x00\x00\x001\x00\x00\x00	\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00blank.gifUT\x05\x00\x01\x13\xde\xa6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00#\x8egQkI\xa2e\xb6\x01\x00\x00\xaf\x01\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81x\x00\x00\x00bottom.pngUT\x05\x00\x01\x13\xde\xa6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00#\x8egQ\xca\x93\x8a?\x93\x03\x00\x00\xc9\x06\x00\x00\x0c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81o\x02\x00\x00iepngfix.htcUT\x05\x00\x01\x13\xde\xa6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xa5]R]\\1\xd8\xf6\x0d\x0e\x00\x00\xf45\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81E\x06\x00\x00index.htmlUT\x05\x00\x01G\xb1\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00#\x8egQ4\xe7\xb3\xaa4\x02\x00\x00\xf6\x04\x00\x00	\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x93\x14\x00\x00plot.htmlUT\x05\x00\x01\x13\xde\xa6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00#\x8egQ\xc3\x86\xc1\xa35\x00\x00\x00.\x00\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x07\x17\x00\x00shadow.gifUT\x05\x00\x01\x13\xde\xa6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00#\x8egQQ\xf1\xa7\xdc\xa8\x01\x00\x00\xa1\x01\x00\x00\x07\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81}\x17\x00\x00top.pngUT\x05\x00\x01\x13\xde\xa6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00#\x8egQ6\xe9[\xc4\xfe\n\x00\x00\x16*\x00\x00\x08\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81c\x19\x00\x00view.cssUT\x05\x00\x01\x13\xde\xa6_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00#\x8egQ\x92\xfbm\xc8Y\x04\x00\x00T\x07\x00\x00\x07\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xa0$\x00\x00view.jsUT\x05\x00\x01\x13\xde\xa6_PK\x05\x06\x00\x00\x00\x00	\x00	\x00A\x02\x00\x007)\x00\x00\x00\x00"
 	fs.Register(data)
 }
@@ -3,7 +3,6 @@ module github.com/Semior001/decompract
 go 1.14
 
 require (
-	github.com/Knetic/govaluate v3.0.0+incompatible
 	github.com/go-chi/chi v4.1.1+incompatible
 	github.com/go-chi/httprate v0.4.0
 	github.com/go-chi/render v1.0.1
@@ -19,4 +18,5 @@ require (
 	golang.org/x/text v0.3.2 // indirect
 	gonum.org/v1/plot v0.8.1
 	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c
 )